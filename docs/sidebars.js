@@ -93,6 +93,7 @@ const sidebars = {
           "reference/command-line/acorn",
           "reference/command-line/acorn_all",
           "reference/command-line/acorn_app",
+          "reference/command-line/acorn_audit",
           "reference/command-line/acorn_build",
           "reference/command-line/acorn_check",
           "reference/command-line/acorn_container",
@@ -109,7 +110,12 @@ const sidebars = {
           "reference/command-line/acorn_logout",
           "reference/command-line/acorn_logs",
           "reference/command-line/acorn_project",
+          "reference/command-line/acorn_project_add-member",
+          "reference/command-line/acorn_project_config",
           "reference/command-line/acorn_project_create",
+          "reference/command-line/acorn_project_members",
+          "reference/command-line/acorn_project_quota",
+          "reference/command-line/acorn_project_remove-member",
           "reference/command-line/acorn_project_rm",
           "reference/command-line/acorn_project_use",
           "reference/command-line/acorn_pull",
@@ -132,7 +138,10 @@ const sidebars = {
           "reference/command-line/acorn_update",
           "reference/command-line/acorn_volume",
           "reference/command-line/acorn_volume_rm",
-          "reference/command-line/acorn_wait"
+          "reference/command-line/acorn_wait",
+          "reference/command-line/acorn_webhook",
+          "reference/command-line/acorn_webhook_create",
+          "reference/command-line/acorn_webhook_rm"
         ]
       },
       {
@@ -27,7 +27,11 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ClusterComputeClass{},
 		&ClusterComputeClassList{},
 		&ProjectComputeClass{},
-		&ProjectComputeClassList{})
+		&ProjectComputeClassList{},
+		&ProjectImagePolicy{},
+		&ProjectImagePolicyList{},
+		&ClusterImagePolicy{},
+		&ClusterImagePolicyList{})
 
 	// Add common types
 	scheme.AddKnownTypes(schemeGroupVersion, &metav1.Status{})
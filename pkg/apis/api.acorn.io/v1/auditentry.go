@@ -0,0 +1,37 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditEntry records a request that a user made to the Acorn API server. The namespace of the entry is the project
+// that the request was made in and the creation timestamp is the time of the request.
+type AuditEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	Verb   string   `json:"verb,omitempty"`
+	// APIGroup, Resource, Subresource and ResourceName identify the object that the request was made for
+	APIGroup     string `json:"apiGroup,omitempty"`
+	Resource     string `json:"resource,omitempty"`
+	Subresource  string `json:"subresource,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Project      string `json:"project,omitempty"`
+	App          string `json:"app,omitempty"`
+	// Request is a summary of the body or the query of the request with secret values redacted
+	Request    string   `json:"request,omitempty"`
+	StatusCode int      `json:"statusCode,omitempty"`
+	SourceIPs  []string `json:"sourceIPs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AuditEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuditEntry `json:"items"`
+}
@@ -35,6 +35,23 @@ func Convert_url_Values_To__ContainerReplicaExecOptions(in, out interface{}, s c
 	return convert_url_Values_To__ContainerReplicaExecOptions(in.(*url.Values), out.(*ContainerReplicaExecOptions), s)
 }
 
+func convert_url_Values_To__ContainerReplicaPortForwardOptions(in *url.Values, out *ContainerReplicaPortForwardOptions, s conversion.Scope) error {
+	if values, ok := map[string][]string(*in)["port"]; ok && len(values) > 0 {
+		var port int64
+		if err := runtime.Convert_Slice_string_To_int64(&values, &port, s); err != nil {
+			return err
+		}
+		out.Port = int32(port)
+	} else {
+		out.Port = 0
+	}
+	return nil
+}
+
+func Convert_url_Values_To__ContainerReplicaPortForwardOptions(in, out interface{}, s conversion.Scope) error {
+	return convert_url_Values_To__ContainerReplicaPortForwardOptions(in.(*url.Values), out.(*ContainerReplicaPortForwardOptions), s)
+}
+
 func convert_url_Values_To__LogOptions(in *url.Values, out *LogOptions, s conversion.Scope) error {
 	if values, ok := map[string][]string(*in)["tailLines"]; ok && len(values) > 0 {
 		out.Tail = new(int64)
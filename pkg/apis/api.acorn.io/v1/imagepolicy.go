@@ -0,0 +1,32 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ImagePolicyScopeProject = "project"
+	ImagePolicyScopeCluster = "cluster"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Scope                  string   `json:"scope,omitempty"`
+	Description            string   `json:"description,omitempty"`
+	PublicKeys             []string `json:"publicKeys,omitempty"`
+	AllowedImages          []string `json:"allowedImages,omitempty"`
+	DeniedImages           []string `json:"deniedImages,omitempty"`
+	VulnerabilityThreshold string   `json:"vulnerabilityThreshold,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePolicy `json:"items"`
+}
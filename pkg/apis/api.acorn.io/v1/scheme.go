@@ -33,6 +33,9 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImageList{},
 		&ImageDetails{},
 		&ImageTag{},
+		&ImageSignature{},
+		&ImageScan{},
+		&ImagePrune{},
 		&ImagePush{},
 		&ImagePull{},
 		&Info{},
@@ -47,6 +50,7 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ContainerReplica{},
 		&ContainerReplicaList{},
 		&ContainerReplicaExecOptions{},
+		&ContainerReplicaPortForwardOptions{},
 		&Secret{},
 		&SecretList{},
 		&Project{},
@@ -55,6 +59,14 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&AcornImageBuildList{},
 		&ComputeClass{},
 		&ComputeClassList{},
+		&ImagePolicy{},
+		&ImagePolicyList{},
+		&UpgradeWebhook{},
+		&UpgradeWebhookList{},
+		&ProjectMembership{},
+		&ProjectMembershipList{},
+		&AuditEntry{},
+		&AuditEntryList{},
 	)
 
 	// Add common types
@@ -67,6 +79,9 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		if err := scheme.AddConversionFunc((*url.Values)(nil), (*ContainerReplicaExecOptions)(nil), Convert_url_Values_To__ContainerReplicaExecOptions); err != nil {
 			return err
 		}
+		if err := scheme.AddConversionFunc((*url.Values)(nil), (*ContainerReplicaPortForwardOptions)(nil), Convert_url_Values_To__ContainerReplicaPortForwardOptions); err != nil {
+			return err
+		}
 		return scheme.AddConversionFunc((*url.Values)(nil), (*LogOptions)(nil), Convert_url_Values_To__LogOptions)
 	}
 
@@ -60,6 +60,7 @@ type ContainerReplicaSpec struct {
 	Environment []v1.EnvVar               `json:"environment,omitempty"`
 	WorkingDir  string                    `json:"workingDir,omitempty"`
 	Ports       []v1.PortDef              `json:"ports,omitempty"`
+	Dev         *v1.Dev                   `json:"dev,omitempty"`
 
 	// Init is only available on sidecars
 	Init bool `json:"init,omitempty"`
@@ -96,9 +97,11 @@ type Image struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Repo   string   `json:"repo,omitempty"`
-	Digest string   `json:"digest,omitempty"`
-	Tags   []string `json:"tags,omitempty"`
+	Repo       string              `json:"repo,omitempty"`
+	Digest     string              `json:"digest,omitempty"`
+	Source     string              `json:"source,omitempty"`
+	Tags       []string            `json:"tags,omitempty"`
+	ScanStatus *v1.ImageScanStatus `json:"scanStatus,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -168,6 +171,8 @@ type ImageDetails struct {
 	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
 	Params     *v1.ParamSpec `json:"params,omitempty"`
 	ParseError string        `json:"parseError,omitempty"`
+	// ScanStatus is the result of the last vulnerability scan of a local image
+	ScanStatus *v1.ImageScanStatus `json:"scanStatus,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -181,6 +186,40 @@ type ImageTag struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type ImageSignature struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Payload is the simple signing payload that was signed
+	Payload []byte `json:"payload,omitempty"`
+	// Signature is the signature of the payload
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageScan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Status          v1.ImageScanStatus `json:"status,omitempty"`
+	Vulnerabilities []v1.Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePrune struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// DryRun reports the images that would be pruned without deleting them
+	DryRun bool `json:"dryRun,omitempty"`
+	// Images are the images that were (or would be) pruned
+	Images []Image `json:"images,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ImageList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
@@ -240,6 +279,15 @@ type ContainerReplicaExecOptions struct {
 	DebugImage string   `json:"debugImage,omitempty"`
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ContainerReplicaPortForwardOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Port int32 `json:"port,omitempty"`
+}
+
 const (
 	SecretTypeCredential = "acorn.io/credential"
 )
@@ -309,6 +357,7 @@ type InfoSpec struct {
 	PublicKeys             []EncryptionKey   `json:"publicKeys,omitempty"`
 	Config                 Config            `json:"config"`
 	UserConfig             Config            `json:"userConfig"`
+	ProjectConfig          *ProjectConfig    `json:"projectConfig,omitempty"`
 	LetsEncryptCertificate string            `json:"letsEncryptCertificate,omitempty"`
 	ExtraData              map[string]string `json:"extraData,omitempty"`
 }
@@ -325,6 +374,10 @@ type Config struct {
 	LetsEncrypt                    *string        `json:"letsEncrypt" name:"lets-encrypt" usage:"enabled|disabled|staging. If enabled, acorn generated endpoints will be secured using TLS certificate from Let's Encrypt. Staging uses Let's Encrypt's staging environment. (default disabled)"`
 	LetsEncryptEmail               string         `json:"letsEncryptEmail" name:"lets-encrypt-email" usage:"Required if --lets-encrypt=enabled. The email address to use for Let's Encrypt registration(default '')"`
 	LetsEncryptTOSAgree            *bool          `json:"letsEncryptTOSAgree" name:"lets-encrypt-tos-agree" usage:"Required if --lets-encrypt=enabled. If true, you agree to the Let's Encrypt terms of service (default false)"`
+	ACMEDirectoryURL               *string        `json:"acmeDirectoryURL" name:"acme-directory-url" usage:"The directory URL of an ACME CA to get certificates from instead of Let's Encrypt when --lets-encrypt is enabled or staging (ex https://ca.example.com/acme/acme/directory)"`
+	ACMEEABSecret                  *string        `json:"acmeEABSecret" name:"acme-eab-secret" usage:"The name of a secret in the acorn-system namespace with the keys kid and hmacKey, used for external account binding with the ACME CA"`
+	ACMEDNSProvider                *string        `json:"acmeDNSProvider" name:"acme-dns-provider" usage:"rfc2136. The DNS-01 provider used to get wildcard certificates for the cluster domains. Acorn DNS domains always use Acorn DNS (default '' - disabled)"`
+	ACMEDNSProviderSecret          *string        `json:"acmeDNSProviderSecret" name:"acme-dns-provider-secret" usage:"The name of a secret in the acorn-system namespace with the settings of the DNS-01 provider. For rfc2136 the keys are nameserver, tsigKey, tsigSecret and tsigAlgorithm"`
 	SetPodSecurityEnforceProfile   *bool          `json:"setPodSecurityEnforceProfile" usage:"Set the PodSecurity profile on created namespaces (default true)"`
 	PodSecurityEnforceProfile      string         `json:"podSecurityEnforceProfile" usage:"The name of the PodSecurity profile to set (default baseline)" wrangler:"nullable"`
 	DefaultPublishMode             v1.PublishMode `json:"defaultPublishMode" usage:"If no publish mode is set default to this value (default user)" wrangler:"nullable,options=all|none|defined"`
@@ -346,6 +399,17 @@ type Config struct {
 	PropagateProjectAnnotations    []string       `json:"propagateProjectAnnotations" name:"propagate-project-annotation" usage:"The list of keys of annotations to propagate from acorn project to app namespaces"`
 	PropagateProjectLabels         []string       `json:"propagateProjectLabels" name:"propagate-project-label" usage:"The list of keys of labels to propagate from acorn project to app namespaces"`
 	ManageVolumeClasses            *bool          `json:"manageVolumeClasses" name:"manage-volume-classes" usage:"Manually manage volume classes rather than sync with storage classes, setting to 'true' will delete Acorn-created volume classes"`
+	VulnerabilityScanner           *string        `json:"vulnerabilityScanner" name:"vulnerability-scanner" usage:"The scanner used to check images for vulnerabilities. 'trivy' runs the trivy CLI, 'file:///path' reads Trivy JSON reports named <digest>.json from a directory (default '' - disabled)"`
+	ImageRetentionCount            *int           `json:"imageRetentionCount" name:"image-retention-count" usage:"The number of most recent untagged images to keep per repository when pruning images. Images that are tagged or referenced by an app are always kept (default 0 - no count limit)"`
+	ImageRetentionMaxAge           *string        `json:"imageRetentionMaxAge" name:"image-retention-max-age" usage:"Untagged images older than this duration that are not referenced by an app can be pruned, for example '720h' (default '' - no age limit)"`
+	ImageGCInterval                *string        `json:"imageGCInterval" name:"image-gc-interval" usage:"The interval at which images are pruned according to the image retention policy and the internal registry is garbage collected (default '24h')"`
+	LockedProjectConfig            []string       `json:"lockedProjectConfig" name:"locked-project-config" usage:"Fields of the config that projects cannot override, by their JSON name (ex workloadMemoryMaximum)"`
+	AuditLogFile                   *string        `json:"auditLogFile" name:"audit-log-file" usage:"Path of the file in the API server that audit entries are written to as JSON lines (default '' - disabled)"`
+	AuditLogMaxSize                *int           `json:"auditLogMaxSize" name:"audit-log-max-size" usage:"The size in megabytes at which the audit log file is rotated (default 100)"`
+	AuditLogMaxBackups             *int           `json:"auditLogMaxBackups" name:"audit-log-max-backups" usage:"The number of rotated audit log files to keep (default 5)"`
+	AuditWebhookURL                *string        `json:"auditWebhookURL" name:"audit-webhook-url" usage:"URL that audit entries are posted to as JSON (default '' - disabled)"`
+	NetworkPolicies                *bool          `json:"networkPolicies" name:"network-policies" usage:"Create NetworkPolicies that only allow traffic to apps from the same app, linked apps, Acorn routers and the ingress controller (default false)"`
+	IngressControllerNamespace     *string        `json:"ingressControllerNamespace" name:"ingress-controller-namespace" usage:"The namespace of the ingress controller that is allowed to reach published HTTP ports when --network-policies is enabled (default '' - any namespace)"`
 }
 
 type EncryptionKey struct {
@@ -366,9 +430,40 @@ type InfoList struct {
 type Project struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              ProjectSpec   `json:"spec,omitempty"`
 	Status            ProjectStatus `json:"status,omitempty"`
 }
 
+type ProjectSpec struct {
+	Quota  *ProjectQuota  `json:"quota,omitempty"`
+	Config *ProjectConfig `json:"config,omitempty"`
+}
+
+// ProjectConfig overrides fields of the cluster Config for the apps of a project. Fields that are not set, or that are
+// locked by the lockedProjectConfig field of the cluster Config, use the value of the cluster Config.
+type ProjectConfig struct {
+	DefaultPublishMode          v1.PublishMode `json:"defaultPublishMode,omitempty" usage:"If no publish mode is set default to this value" wrangler:"nullable,options=all|none|defined"`
+	HttpEndpointPattern         *string        `json:"httpEndpointPattern,omitempty" name:"http-endpoint-pattern" usage:"Go template for formatting application http endpoints. Valid variables to use are: App, Container, Namespace, Hash and ClusterDomain"`
+	WorkloadMemoryDefault       *int64         `json:"workloadMemoryDefault,omitempty" name:"workload-memory-default" quantity:"true" usage:"Set the default memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators"`
+	WorkloadMemoryMaximum       *int64         `json:"workloadMemoryMaximum,omitempty" name:"workload-memory-maximum" quantity:"true" usage:"Set the maximum memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators"`
+	AutoUpgradeInterval         *string        `json:"autoUpgradeInterval,omitempty" name:"auto-upgrade-interval" usage:"For apps configured with automatic upgrades enabled, the interval at which to check for new versions"`
+	PropagateProjectAnnotations []string       `json:"propagateProjectAnnotations,omitempty" name:"propagate-project-annotation" usage:"The list of keys of annotations to propagate from acorn project to app namespaces"`
+	PropagateProjectLabels      []string       `json:"propagateProjectLabels,omitempty" name:"propagate-project-label" usage:"The list of keys of labels to propagate from acorn project to app namespaces"`
+	// PodSecurityEnforceProfile can only be stricter than the profile of the cluster Config
+	PodSecurityEnforceProfile string `json:"podSecurityEnforceProfile,omitempty" name:"pod-security-enforce-profile" usage:"The PodSecurity profile required for the apps of the project, must be stricter than the cluster profile (baseline or restricted)"`
+}
+
+// ProjectQuota limits the resources that the apps of a project can use. A limit that is not set is unlimited.
+type ProjectQuota struct {
+	Apps               *int32             `json:"apps,omitempty"`
+	Memory             *resource.Quantity `json:"memory,omitempty"`
+	CPU                *resource.Quantity `json:"cpu,omitempty"`
+	VolumeStorage      *resource.Quantity `json:"volumeStorage,omitempty"`
+	PublishedEndpoints *int32             `json:"publishedEndpoints,omitempty"`
+	// ContainerReplicas is the maximum number of replicas of a single container
+	ContainerReplicas *int32 `json:"containerReplicas,omitempty"`
+}
+
 type ProjectStatus struct {
 	Placement string `json:"placement,omitempty"`
 	Namespace string `json:"namespace,omitempty"`
@@ -153,6 +153,73 @@ func (in *AppPullImage) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditEntry) DeepCopyInto(out *AuditEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditEntry.
+func (in *AuditEntry) DeepCopy() *AuditEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuditEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditEntryList) DeepCopyInto(out *AuditEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuditEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditEntryList.
+func (in *AuditEntryList) DeepCopy() *AuditEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuditEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builder) DeepCopyInto(out *Builder) {
 	*out = *in
@@ -316,6 +383,26 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ACMEDirectoryURL != nil {
+		in, out := &in.ACMEDirectoryURL, &out.ACMEDirectoryURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ACMEEABSecret != nil {
+		in, out := &in.ACMEEABSecret, &out.ACMEEABSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.ACMEDNSProvider != nil {
+		in, out := &in.ACMEDNSProvider, &out.ACMEDNSProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.ACMEDNSProviderSecret != nil {
+		in, out := &in.ACMEDNSProviderSecret, &out.ACMEDNSProviderSecret
+		*out = new(string)
+		**out = **in
+	}
 	if in.SetPodSecurityEnforceProfile != nil {
 		in, out := &in.SetPodSecurityEnforceProfile, &out.SetPodSecurityEnforceProfile
 		*out = new(bool)
@@ -406,6 +493,61 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VulnerabilityScanner != nil {
+		in, out := &in.VulnerabilityScanner, &out.VulnerabilityScanner
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageRetentionCount != nil {
+		in, out := &in.ImageRetentionCount, &out.ImageRetentionCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ImageRetentionMaxAge != nil {
+		in, out := &in.ImageRetentionMaxAge, &out.ImageRetentionMaxAge
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageGCInterval != nil {
+		in, out := &in.ImageGCInterval, &out.ImageGCInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.LockedProjectConfig != nil {
+		in, out := &in.LockedProjectConfig, &out.LockedProjectConfig
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditLogFile != nil {
+		in, out := &in.AuditLogFile, &out.AuditLogFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuditLogMaxSize != nil {
+		in, out := &in.AuditLogMaxSize, &out.AuditLogMaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.AuditLogMaxBackups != nil {
+		in, out := &in.AuditLogMaxBackups, &out.AuditLogMaxBackups
+		*out = new(int)
+		**out = **in
+	}
+	if in.AuditWebhookURL != nil {
+		in, out := &in.AuditWebhookURL, &out.AuditWebhookURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressControllerNamespace != nil {
+		in, out := &in.IngressControllerNamespace, &out.IngressControllerNamespace
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Config.
@@ -546,6 +688,30 @@ func (in *ContainerReplicaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerReplicaPortForwardOptions) DeepCopyInto(out *ContainerReplicaPortForwardOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerReplicaPortForwardOptions.
+func (in *ContainerReplicaPortForwardOptions) DeepCopy() *ContainerReplicaPortForwardOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerReplicaPortForwardOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContainerReplicaPortForwardOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerReplicaSpec) DeepCopyInto(out *ContainerReplicaSpec) {
 	*out = *in
@@ -588,6 +754,11 @@ func (in *ContainerReplicaSpec) DeepCopyInto(out *ContainerReplicaSpec) {
 		*out = make([]internal_acorn_iov1.PortDef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Dev != nil {
+		in, out := &in.Dev, &out.Dev
+		*out = new(internal_acorn_iov1.Dev)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sidecars != nil {
 		in, out := &in.Sidecars, &out.Sidecars
 		*out = make(map[string]internal_acorn_iov1.Container, len(*in))
@@ -724,6 +895,11 @@ func (in *Image) DeepCopyInto(out *Image) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ScanStatus != nil {
+		in, out := &in.ScanStatus, &out.ScanStatus
+		*out = new(internal_acorn_iov1.ImageScanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -766,6 +942,11 @@ func (in *ImageDetails) DeepCopyInto(out *ImageDetails) {
 		*out = new(internal_acorn_iov1.ParamSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScanStatus != nil {
+		in, out := &in.ScanStatus, &out.ScanStatus
+		*out = new(internal_acorn_iov1.ImageScanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDetails.
@@ -818,6 +999,110 @@ func (in *ImageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicy) DeepCopyInto(out *ImagePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedImages != nil {
+		in, out := &in.AllowedImages, &out.AllowedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedImages != nil {
+		in, out := &in.DeniedImages, &out.DeniedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicy.
+func (in *ImagePolicy) DeepCopy() *ImagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicyList) DeepCopyInto(out *ImagePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImagePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicyList.
+func (in *ImagePolicyList) DeepCopy() *ImagePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrune) DeepCopyInto(out *ImagePrune) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]Image, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrune.
+func (in *ImagePrune) DeepCopy() *ImagePrune {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrune) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePull) DeepCopyInto(out *ImagePull) {
 	*out = *in
@@ -876,6 +1161,72 @@ func (in *ImagePush) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageScan) DeepCopyInto(out *ImageScan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]internal_acorn_iov1.Vulnerability, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageScan.
+func (in *ImageScan) DeepCopy() *ImageScan {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageScan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignature) DeepCopyInto(out *ImageSignature) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignature.
+func (in *ImageSignature) DeepCopy() *ImageSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSignature) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageTag) DeepCopyInto(out *ImageTag) {
 	*out = *in
@@ -971,6 +1322,11 @@ func (in *InfoSpec) DeepCopyInto(out *InfoSpec) {
 	}
 	in.Config.DeepCopyInto(&out.Config)
 	in.UserConfig.DeepCopyInto(&out.UserConfig)
+	if in.ProjectConfig != nil {
+		in, out := &in.ProjectConfig, &out.ProjectConfig
+		*out = new(ProjectConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtraData != nil {
 		in, out := &in.ExtraData, &out.ExtraData
 		*out = make(map[string]string, len(*in))
@@ -1040,6 +1396,7 @@ func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 }
 
@@ -1061,6 +1418,51 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConfig) DeepCopyInto(out *ProjectConfig) {
+	*out = *in
+	if in.HttpEndpointPattern != nil {
+		in, out := &in.HttpEndpointPattern, &out.HttpEndpointPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkloadMemoryDefault != nil {
+		in, out := &in.WorkloadMemoryDefault, &out.WorkloadMemoryDefault
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WorkloadMemoryMaximum != nil {
+		in, out := &in.WorkloadMemoryMaximum, &out.WorkloadMemoryMaximum
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoUpgradeInterval != nil {
+		in, out := &in.AutoUpgradeInterval, &out.AutoUpgradeInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.PropagateProjectAnnotations != nil {
+		in, out := &in.PropagateProjectAnnotations, &out.PropagateProjectAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PropagateProjectLabels != nil {
+		in, out := &in.PropagateProjectLabels, &out.PropagateProjectLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfig.
+func (in *ProjectConfig) DeepCopy() *ProjectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -1093,6 +1495,134 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMembership) DeepCopyInto(out *ProjectMembership) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMembership.
+func (in *ProjectMembership) DeepCopy() *ProjectMembership {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMembership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectMembership) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMembershipList) DeepCopyInto(out *ProjectMembershipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectMembership, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMembershipList.
+func (in *ProjectMembershipList) DeepCopy() *ProjectMembershipList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMembershipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectMembershipList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuota) DeepCopyInto(out *ProjectQuota) {
+	*out = *in
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.VolumeStorage != nil {
+		in, out := &in.VolumeStorage, &out.VolumeStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.PublishedEndpoints != nil {
+		in, out := &in.PublishedEndpoints, &out.PublishedEndpoints
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContainerReplicas != nil {
+		in, out := &in.ContainerReplicas, &out.ContainerReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuota.
+func (in *ProjectQuota) DeepCopy() *ProjectQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ProjectConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
@@ -1200,6 +1730,65 @@ func (in *SecretList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeWebhook) DeepCopyInto(out *UpgradeWebhook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeWebhook.
+func (in *UpgradeWebhook) DeepCopy() *UpgradeWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeWebhook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeWebhookList) DeepCopyInto(out *UpgradeWebhookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpgradeWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeWebhookList.
+func (in *UpgradeWebhookList) DeepCopy() *UpgradeWebhookList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeWebhookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeWebhookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
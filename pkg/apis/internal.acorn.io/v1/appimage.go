@@ -1,5 +1,11 @@
 package v1
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 type AppImage struct {
 	ID        string     `json:"id,omitempty"`
 	Name      string     `json:"name,omitempty"`
@@ -8,6 +14,8 @@ type AppImage struct {
 	ImageData ImagesData `json:"imageData,omitempty"`
 	BuildArgs GenericMap `json:"buildArgs,omitempty"`
 	VCS       VCS        `json:"vcs,omitempty"`
+	// Platforms are the platforms supported by every image of the app. Empty if the platforms are not known.
+	Platforms []Platform `json:"platforms,omitempty"`
 }
 
 type VCS struct {
@@ -22,3 +30,56 @@ type Platform struct {
 	OSFeatures   []string `json:"os.features,omitempty"`
 	Variant      string   `json:"variant,omitempty"`
 }
+
+func (in Platform) String() string {
+	result := in.OS + "/" + in.Architecture
+	if in.Variant != "" {
+		result += "/" + in.Variant
+	}
+	return result
+}
+
+// UnmarshalJSON accepts the string form os/arch[/variant] of a platform, as written in an Acornfile, in addition to
+// the object form.
+func (in *Platform) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		type platform Platform
+		return json.Unmarshal(data, (*platform)(in))
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	parts := strings.Split(str, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid platform %q, must be in the form os/arch[/variant]", str)
+	}
+
+	*in = Platform{
+		OS:           parts[0],
+		Architecture: parts[1],
+	}
+	if len(parts) == 3 {
+		in.Variant = parts[2]
+	}
+	return nil
+}
+
+// Matches returns true if the OS and architecture of the platforms are the same and the variant is either the same
+// or not set on one of them
+func (in Platform) Matches(other Platform) bool {
+	return in.OS == other.OS && in.Architecture == other.Architecture &&
+		(in.Variant == "" || other.Variant == "" || in.Variant == other.Variant)
+}
+
+// HasPlatform returns true if any of the platforms matches platform
+func HasPlatform(platforms []Platform, platform Platform) bool {
+	for _, p := range platforms {
+		if p.Matches(platform) {
+			return true
+		}
+	}
+	return false
+}
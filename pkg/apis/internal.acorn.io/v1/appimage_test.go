@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformUnmarshalJSON(t *testing.T) {
+	var build Build
+	err := json.Unmarshal([]byte(`{"platforms": ["linux/amd64", "linux/arm/v7", {"os": "windows", "architecture": "amd64", "os.version": "10.0"}]}`), &build)
+	require.NoError(t, err)
+	assert.Equal(t, []Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+		{OS: "windows", Architecture: "amd64", OSVersion: "10.0"},
+	}, build.Platforms)
+	assert.Equal(t, "linux/arm/v7", build.Platforms[1].String())
+
+	for _, invalid := range []string{`"linux"`, `"linux/"`, `"linux/arm/v7/extra"`} {
+		var p Platform
+		assert.Error(t, json.Unmarshal([]byte(invalid), &p), invalid)
+	}
+}
+
+func TestImagesDataPlatforms(t *testing.T) {
+	var (
+		amd64 = Platform{OS: "linux", Architecture: "amd64"}
+		arm64 = Platform{OS: "linux", Architecture: "arm64"}
+		armv7 = Platform{OS: "linux", Architecture: "arm", Variant: "v7"}
+	)
+
+	assert.Nil(t, ImagesData{}.Platforms())
+
+	data := ImagesData{
+		Containers: map[string]ContainerData{
+			"web": {
+				Platforms: []Platform{arm64, amd64, armv7},
+				Sidecars: map[string]ImageData{
+					"proxy": {Platforms: []Platform{amd64, arm64}},
+				},
+			},
+			"legacy": {},
+		},
+		Jobs: map[string]ContainerData{
+			"migrate": {Platforms: []Platform{arm64, {OS: "linux", Architecture: "amd64", Variant: "v2"}}},
+		},
+	}
+	assert.Equal(t, []Platform{amd64, arm64}, data.Platforms())
+
+	data.Images = map[string]ImageData{
+		"tool": {Platforms: []Platform{armv7}},
+	}
+	assert.Empty(t, data.Platforms())
+}
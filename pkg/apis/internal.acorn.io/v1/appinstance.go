@@ -23,6 +23,7 @@ var (
 	AppInstanceConditionReady      = "Ready"
 	AppInstanceConditionUpgrade    = "upgrade"
 	AppInstanceConditionVolumes    = "volumes"
+	AppInstanceConditionDomains    = "domains"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -77,8 +78,10 @@ type AppInstanceSpec struct {
 	AutoUpgrade         *bool            `json:"autoUpgrade,omitempty"`
 	NotifyUpgrade       *bool            `json:"notifyUpgrade,omitempty"`
 	AutoUpgradeInterval string           `json:"autoUpgradeInterval,omitempty"`
+	UpgradePolicy       *UpgradePolicy   `json:"upgradePolicy,omitempty"`
 	ComputeClass        ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory              MemoryMap        `json:"memory,omitempty"`
+	Domains             []DomainBinding  `json:"domains,omitempty"`
 }
 
 func (in *AppInstanceSpec) GetAutoUpgrade() bool {
@@ -114,6 +117,12 @@ type ServiceBinding struct {
 	Service string `json:"service,omitempty"`
 }
 
+// DomainBinding serves a container or router of the app on a custom domain once the ownership of the domain is verified
+type DomainBinding struct {
+	Domain string `json:"domain,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
 type SecretBinding struct {
 	Secret string `json:"secret,omitempty"`
 	Target string `json:"target,omitempty"`
@@ -168,6 +177,20 @@ type AppInstanceStatus struct {
 	Conditions             []Condition                `json:"conditions,omitempty"`
 	Endpoints              []Endpoint                 `json:"endpoints,omitempty"`
 	Defaults               Defaults                   `json:"defaults,omitempty"`
+	UpgradeSoak            *UpgradeSoak               `json:"upgradeSoak,omitempty"`
+	UpgradeHistory         []UpgradeDecision          `json:"upgradeHistory,omitempty"`
+	Domains                []DomainStatus             `json:"domains,omitempty"`
+	NetworkPolicies        bool                       `json:"networkPolicies,omitempty"`
+}
+
+// DomainStatus is the ownership verification of a custom domain. The domain is verified once the TXT record Record
+// contains Token.
+type DomainStatus struct {
+	Domain   string `json:"domain,omitempty"`
+	Record   string `json:"record,omitempty"`
+	Token    string `json:"token,omitempty"`
+	Verified bool   `json:"verified,omitempty"`
+	Message  string `json:"message,omitempty"`
 }
 
 type Defaults struct {
@@ -185,6 +208,8 @@ type Scheduling struct {
 	Requirements corev1.ResourceRequirements `json:"requirements,omitempty"`
 	Affinity     *corev1.Affinity            `json:"affinity,omitempty"`
 	Tolerations  []corev1.Toleration         `json:"tolerations,omitempty"`
+
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 type Endpoint struct {
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -39,6 +40,8 @@ type Build struct {
 	BaseImage          string            `json:"baseImage,omitempty"`
 	ContextDirs        map[string]string `json:"contextDirs,omitempty"`
 	BuildArgs          map[string]string `json:"buildArgs,omitempty"`
+	// Platforms overrides the platforms the image is built for
+	Platforms []Platform `json:"platforms,omitempty"`
 }
 
 func (in Build) BaseBuild() Build {
@@ -244,6 +247,14 @@ type Permissions struct {
 	ServiceName  string              `json:"serviceName,omitempty"`
 	Rules        []PolicyRule        `json:"rules,omitempty"`
 	ClusterRules []ClusterPolicyRule `json:"clusterRules,omitempty"`
+	Egress       []EgressRule        `json:"egress,omitempty"`
+
+	// Capabilities are the Linux capabilities added to the containers of the service
+	Capabilities []string `json:"capabilities,omitempty"`
+	// SeccompUnconfined is true if a container of the service runs without a seccomp profile
+	SeccompUnconfined bool `json:"seccompUnconfined,omitempty"`
+	// PerNode is true if the service runs a replica on every node
+	PerNode bool `json:"perNode,omitempty"`
 }
 
 func (in *Permissions) HasRules() bool {
@@ -286,26 +297,38 @@ type Dependencies []Dependency
 type ScopedLabels []ScopedLabel
 
 type Container struct {
-	Labels       map[string]string      `json:"labels,omitempty"`
-	Annotations  map[string]string      `json:"annotations,omitempty"`
-	Dirs         map[string]VolumeMount `json:"dirs,omitempty"`
-	Files        Files                  `json:"files,omitempty"`
-	Image        string                 `json:"image,omitempty"`
-	Build        *Build                 `json:"build,omitempty"`
-	Command      CommandSlice           `json:"command,omitempty"`
-	Interactive  bool                   `json:"interactive,omitempty"`
-	Entrypoint   CommandSlice           `json:"entrypoint,omitempty"`
-	Environment  EnvVars                `json:"environment,omitempty"`
-	WorkingDir   string                 `json:"workingDir,omitempty"`
-	Ports        Ports                  `json:"ports,omitempty"`
-	Probes       Probes                 `json:"probes"` // Don't omitempty so that nil vs empty is recorded
-	Dependencies Dependencies           `json:"dependencies,omitempty"`
-	Permissions  *Permissions           `json:"permissions,omitempty"`
-	ComputeClass *string                `json:"class,omitempty"`
-	Memory       *int64                 `json:"memory,omitempty"`
-
-	// Scale is only available on containers, not sidecars or jobs
+	Labels          map[string]string      `json:"labels,omitempty"`
+	Annotations     map[string]string      `json:"annotations,omitempty"`
+	Dirs            map[string]VolumeMount `json:"dirs,omitempty"`
+	Files           Files                  `json:"files,omitempty"`
+	Image           string                 `json:"image,omitempty"`
+	Build           *Build                 `json:"build,omitempty"`
+	Command         CommandSlice           `json:"command,omitempty"`
+	Interactive     bool                   `json:"interactive,omitempty"`
+	Entrypoint      CommandSlice           `json:"entrypoint,omitempty"`
+	Environment     EnvVars                `json:"environment,omitempty"`
+	WorkingDir      string                 `json:"workingDir,omitempty"`
+	Ports           Ports                  `json:"ports,omitempty"`
+	Probes          Probes                 `json:"probes"` // Don't omitempty so that nil vs empty is recorded
+	Dependencies    Dependencies           `json:"dependencies,omitempty"`
+	Permissions     *Permissions           `json:"permissions,omitempty"`
+	Egress          []EgressRule           `json:"egress,omitempty"`
+	SecurityContext *SecurityContext       `json:"securityContext,omitempty"`
+	ComputeClass    *string                `json:"class,omitempty"`
+	Memory          *int64                 `json:"memory,omitempty"`
+	Dev             *Dev                   `json:"dev,omitempty"`
+
+	// Scale, MaxUnavailable, Spread, Stateful and PerNode are only available on containers, not sidecars or jobs
 	Scale *int32 `json:"scale,omitempty"`
+	// MaxUnavailable is the number or percentage of replicas that can be disrupted at once, such as when a node is
+	// drained, if the container runs more than one replica
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	Spread         TopologySpreads     `json:"spread,omitempty"`
+	// Stateful runs the container as a StatefulSet where each replica has a stable name and its own copy of the
+	// persistent volumes that the container uses
+	Stateful bool `json:"stateful,omitempty"`
+	// PerNode runs the container as a DaemonSet with one replica on each node that matches its compute class
+	PerNode bool `json:"perNode,omitempty"`
 
 	// Schedule is only available on jobs
 	Schedule string `json:"schedule,omitempty"`
@@ -317,6 +340,62 @@ type Container struct {
 	Sidecars map[string]Container `json:"sidecars,omitempty"`
 }
 
+type TopologySpreads []TopologySpread
+
+// TopologySpread spreads the replicas of a container across the zones or nodes of the cluster
+type TopologySpread struct {
+	// Topology is zone, node, or the key of the node label to spread across
+	Topology string `json:"topology,omitempty"`
+	// MaxSkew is the maximum difference of the number of replicas between two zones or nodes, defaults to 1
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+	// Required prevents replicas from being scheduled if they would exceed the skew, instead of only preferring not to
+	Required bool `json:"required,omitempty"`
+}
+
+// SecurityContext configures the user, root file system, Linux capabilities and seccomp profile of a container
+type SecurityContext struct {
+	RunAsUser              *int64        `json:"runAsUser,omitempty"`
+	RunAsGroup             *int64        `json:"runAsGroup,omitempty"`
+	RunAsNonRoot           *bool         `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem *bool         `json:"readOnlyRootFilesystem,omitempty"`
+	Capabilities           *Capabilities `json:"capabilities,omitempty"`
+	// SeccompProfile is RuntimeDefault, Unconfined, or localhost/<path> for a profile on the node
+	SeccompProfile string `json:"seccompProfile,omitempty"`
+}
+
+type Capabilities struct {
+	Add  []string `json:"add,omitempty"`
+	Drop []string `json:"drop,omitempty"`
+}
+
+// Dev holds the settings of a container that only apply in dev mode
+type Dev struct {
+	Sync  *DevSync  `json:"sync,omitempty"`
+	Debug *DevDebug `json:"debug,omitempty"`
+}
+
+// DevSync configures pushing source changes into the running replicas of a container in dev mode, followed by a
+// lightweight reload instead of a rebuild and rollout
+type DevSync struct {
+	// Paths maps a directory in the container to a directory relative to the build context
+	Paths map[string]string `json:"paths,omitempty"`
+	// Ignore holds .dockerignore style patterns of files that are not synced, in addition to the .dockerignore file
+	Ignore []string `json:"ignore,omitempty"`
+	// Command is run in the container after a batch of changes is synced
+	Command CommandSlice `json:"command,omitempty"`
+	// Signal is sent to the main process of the container after a batch of changes is synced and the command, if
+	// any, finished
+	Signal string `json:"signal,omitempty"`
+}
+
+// DevDebug configures attaching a remote debugger to a container in dev mode
+type DevDebug struct {
+	// Port is the port the debugger listens on in the container, it is forwarded to localhost in dev mode
+	Port int32 `json:"port,omitempty"`
+	// Command replaces the entrypoint and command of the container to launch the process under the debugger
+	Command CommandSlice `json:"command,omitempty"`
+}
+
 type Image struct {
 	Image string `json:"image,omitempty"`
 	Build *Build `json:"build,omitempty"`
@@ -331,6 +410,9 @@ type AppSpec struct {
 	Volumes     map[string]VolumeRequest `json:"volumes,omitempty"`
 	Secrets     map[string]Secret        `json:"secrets,omitempty"`
 	Routers     map[string]Router        `json:"routers,omitempty"`
+
+	// Egress applies to all containers and jobs of the app
+	Egress []EgressRule `json:"egress,omitempty"`
 }
 
 type Route struct {
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"net"
+	"strings"
+)
+
+const (
+	// Rules allowing IPv4 networks larger than /16 or IPv6 networks larger than /48 are broad
+	broadIPv4PrefixLength = 16
+	broadIPv6PrefixLength = 48
+)
+
+// EgressRule allows a container to open connections to the listed hosts and CIDRs on the listed ports. A rule without
+// hosts and CIDRs allows all destinations and a rule without ports allows all ports. Network policies can't match host
+// names, so hosts are only enforced by the ports of the rule.
+type EgressRule struct {
+	Hosts    []string `json:"hosts,omitempty"`
+	CIDRs    []string `json:"cidrs,omitempty"`
+	Ports    []int32  `json:"ports,omitempty"`
+	Protocol Protocol `json:"protocol,omitempty"`
+}
+
+// IsBroad returns true if the rule allows connections to all destinations, to a wildcard host under a top level
+// domain, to hosts on all ports, or to a large network. Broad rules must be approved before the app is run.
+func (in EgressRule) IsBroad() bool {
+	if len(in.Hosts) == 0 && len(in.CIDRs) == 0 {
+		return true
+	}
+
+	// Hosts are enforced by port, so hosts without ports allow all traffic
+	if len(in.Hosts) > 0 && len(in.Ports) == 0 {
+		return true
+	}
+
+	for _, host := range in.Hosts {
+		if host == "*" {
+			return true
+		}
+		if strings.HasPrefix(host, "*.") && !strings.Contains(strings.TrimPrefix(host, "*."), ".") {
+			return true
+		}
+	}
+
+	for _, cidr := range in.CIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			// Invalid CIDRs can't be enforced, so they are treated as allowing everything
+			return true
+		}
+		ones, bits := network.Mask.Size()
+		if bits == net.IPv4len*8 && ones < broadIPv4PrefixLength {
+			return true
+		}
+		if bits == net.IPv6len*8 && ones < broadIPv6PrefixLength {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BroadEgress returns the rules that must be approved before the app is run
+func BroadEgress(rules []EgressRule) (result []EgressRule) {
+	for _, rule := range rules {
+		if rule.IsBroad() {
+			result = append(result, rule)
+		}
+	}
+	return
+}
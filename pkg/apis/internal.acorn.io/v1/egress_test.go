@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgressRuleUnmarshalJSON(t *testing.T) {
+	var rules []EgressRule
+	err := json.Unmarshal([]byte(`["api.example.com:443", "10.0.0.0/8:5432", "fd00::/64", "*.example.com", {"hosts": ["smtp.example.com"], "ports": [25, 587], "protocol": "tcp"}]`), &rules)
+	require.NoError(t, err)
+	assert.Equal(t, []EgressRule{
+		{Hosts: []string{"api.example.com"}, Ports: []int32{443}},
+		{CIDRs: []string{"10.0.0.0/8"}, Ports: []int32{5432}},
+		{CIDRs: []string{"fd00::/64"}},
+		{Hosts: []string{"*.example.com"}},
+		{Hosts: []string{"smtp.example.com"}, Ports: []int32{25, 587}, Protocol: ProtocolTCP},
+	}, rules)
+
+	var rule EgressRule
+	assert.Error(t, json.Unmarshal([]byte(`":443"`), &rule))
+}
+
+func TestEgressRuleIsBroad(t *testing.T) {
+	tests := []struct {
+		rule  EgressRule
+		broad bool
+	}{
+		{rule: EgressRule{}, broad: true},
+		{rule: EgressRule{Ports: []int32{443}}, broad: true},
+		{rule: EgressRule{Hosts: []string{"*"}, Ports: []int32{443}}, broad: true},
+		{rule: EgressRule{Hosts: []string{"*.com"}, Ports: []int32{443}}, broad: true},
+		{rule: EgressRule{Hosts: []string{"*.example.com"}, Ports: []int32{443}}, broad: false},
+		{rule: EgressRule{Hosts: []string{"api.example.com"}, Ports: []int32{443}}, broad: false},
+		{rule: EgressRule{Hosts: []string{"api.example.com"}}, broad: true},
+		{rule: EgressRule{CIDRs: []string{"0.0.0.0/0"}}, broad: true},
+		{rule: EgressRule{CIDRs: []string{"10.0.0.0/8"}}, broad: true},
+		{rule: EgressRule{CIDRs: []string{"10.1.0.0/16"}}, broad: false},
+		{rule: EgressRule{CIDRs: []string{"fd00::/8"}}, broad: true},
+		{rule: EgressRule{CIDRs: []string{"fd00::/64"}}, broad: false},
+		{rule: EgressRule{CIDRs: []string{"invalid"}}, broad: true},
+		{rule: EgressRule{Hosts: []string{"api.example.com"}, CIDRs: []string{"0.0.0.0/0"}, Ports: []int32{443}}, broad: true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.broad, tt.rule.IsBroad(), "%+v", tt.rule)
+	}
+}
@@ -1,16 +1,20 @@
 package v1
 
 import (
+	"sort"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type ContainerData struct {
-	Image    string               `json:"image,omitempty"`
-	Sidecars map[string]ImageData `json:"sidecars,omitempty"`
+	Image     string               `json:"image,omitempty"`
+	Platforms []Platform           `json:"platforms,omitempty"`
+	Sidecars  map[string]ImageData `json:"sidecars,omitempty"`
 }
 
 type ImageData struct {
-	Image string `json:"image,omitempty"`
+	Image     string     `json:"image,omitempty"`
+	Platforms []Platform `json:"platforms,omitempty"`
 }
 
 type ImagesData struct {
@@ -19,6 +23,50 @@ type ImagesData struct {
 	Images     map[string]ImageData     `json:"images,omitempty"`
 }
 
+// Platforms returns the platforms supported by all the images, sorted by name. Images with no recorded platforms are
+// ignored.
+func (in ImagesData) Platforms() []Platform {
+	var (
+		result []Platform
+		found  bool
+	)
+
+	intersect := func(platforms []Platform) {
+		if len(platforms) == 0 {
+			return
+		}
+		if !found {
+			found = true
+			result = append([]Platform{}, platforms...)
+			return
+		}
+		var next []Platform
+		for _, platform := range result {
+			if HasPlatform(platforms, platform) {
+				next = append(next, platform)
+			}
+		}
+		result = next
+	}
+
+	for _, containers := range []map[string]ContainerData{in.Containers, in.Jobs} {
+		for _, container := range containers {
+			intersect(container.Platforms)
+			for _, sidecar := range container.Sidecars {
+				intersect(sidecar.Platforms)
+			}
+		}
+	}
+	for _, image := range in.Images {
+		intersect(image.Platforms)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ImageInstanceList struct {
@@ -33,9 +81,11 @@ type ImageInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Repo   string   `json:"repo,omitempty"`
-	Digest string   `json:"digest,omitempty"`
-	Tags   []string `json:"tags,omitempty"`
+	Repo       string           `json:"repo,omitempty"`
+	Digest     string           `json:"digest,omitempty"`
+	Source     string           `json:"source,omitempty"`
+	Tags       []string         `json:"tags,omitempty"`
+	ScanStatus *ImageScanStatus `json:"scanStatus,omitempty"`
 }
 
 func (in *ImageInstance) ShortID() string {
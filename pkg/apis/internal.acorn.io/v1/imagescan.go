@@ -0,0 +1,33 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SeverityCritical = "CRITICAL"
+	SeverityHigh     = "HIGH"
+	SeverityMedium   = "MEDIUM"
+	SeverityLow      = "LOW"
+	SeverityUnknown  = "UNKNOWN"
+)
+
+type ImageScanStatus struct {
+	Scanner   string      `json:"scanner,omitempty"`
+	Digest    string      `json:"digest,omitempty"`
+	ScannedAt metav1.Time `json:"scannedAt,omitempty"`
+	Critical  int         `json:"critical,omitempty"`
+	High      int         `json:"high,omitempty"`
+	Medium    int         `json:"medium,omitempty"`
+	Low       int         `json:"low,omitempty"`
+	Unknown   int         `json:"unknown,omitempty"`
+}
+
+type Vulnerability struct {
+	ID               string `json:"id,omitempty"`
+	Package          string `json:"package,omitempty"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Severity         string `json:"severity,omitempty"`
+	Title            string `json:"title,omitempty"`
+}
@@ -239,6 +239,25 @@ func ParseLinks(args []string) (result []ServiceBinding, _ error) {
 	return
 }
 
+func ParseDomains(args []string) (result []DomainBinding, _ error) {
+	for _, arg := range args {
+		domain, target, ok := strings.Cut(arg, ":")
+		domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+		target = strings.TrimSpace(target)
+		if !ok || domain == "" || target == "" {
+			return nil, fmt.Errorf("invalid domain binding [%s] must be in the format domain:container-name", arg)
+		}
+		if !nameRegexp.MatchString(domain) || !strings.Contains(domain, ".") {
+			return nil, fmt.Errorf("invalid domain binding [%s] domain [%s] is not a valid domain name", arg, domain)
+		}
+		result = append(result, DomainBinding{
+			Domain: domain,
+			Target: target,
+		})
+	}
+	return
+}
+
 func ParseSecrets(args []string) (result []SecretBinding, _ error) {
 	for _, arg := range args {
 		existing, secName, ok := strings.Cut(arg, ":")
@@ -70,3 +70,17 @@ func TestParseVolumesWithBinding(t *testing.T) {
 		Class:  "aclass",
 	}, vs[6])
 }
+
+func TestParseDomains(t *testing.T) {
+	ds, err := ParseDomains([]string{"API.example.com.:web", " www.example.com : router "})
+	assert.NoError(t, err)
+	assert.Equal(t, []DomainBinding{
+		{Domain: "api.example.com", Target: "web"},
+		{Domain: "www.example.com", Target: "router"},
+	}, ds)
+
+	for _, arg := range []string{"api.example.com", "api.example.com:", ":web", "localhost:web", "api_example.com:web"} {
+		_, err := ParseDomains([]string{arg})
+		assert.Error(t, err, arg)
+	}
+}
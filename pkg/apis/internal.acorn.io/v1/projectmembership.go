@@ -0,0 +1,42 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ProjectMemberKindUser  = "User"
+	ProjectMemberKindGroup = "Group"
+
+	ProjectRoleAdmin    = "admin"
+	ProjectRoleEdit     = "edit"
+	ProjectRoleView     = "view"
+	ProjectRoleViewLogs = "view-logs"
+	ProjectRoleBuild    = "build"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectMembershipInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectMembershipSpec `json:"spec,omitempty"`
+}
+
+type ProjectMembershipSpec struct {
+	// Kind is the kind of the member, either User or Group
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the user or group as known to the Kubernetes authenticator
+	Name string `json:"name,omitempty"`
+	// Roles are the project roles granted to the member: admin, edit, view, view-logs, or build
+	Roles []string `json:"roles,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectMembershipInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectMembershipInstance `json:"items"`
+}
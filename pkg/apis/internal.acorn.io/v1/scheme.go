@@ -23,7 +23,11 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&AppInstance{},
 		&AppInstanceList{},
 		&ImageInstance{},
-		&ImageInstanceList{})
+		&ImageInstanceList{},
+		&UpgradeWebhookInstance{},
+		&UpgradeWebhookInstanceList{},
+		&ProjectMembershipInstance{},
+		&ProjectMembershipInstanceList{})
 
 	// Add common types
 	scheme.AddKnownTypes(SchemeGroupVersion, &metav1.Status{})
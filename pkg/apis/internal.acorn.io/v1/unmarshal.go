@@ -590,6 +590,78 @@ func (in *Permissions) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (in *EgressRule) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		type egressRule EgressRule
+		return json.Unmarshal(data, (*egressRule)(in))
+	}
+
+	s, err := parseString(data)
+	if err != nil {
+		return err
+	}
+	rule, err := ParseEgressRule(s)
+	if err != nil {
+		return err
+	}
+	*in = rule
+	return nil
+}
+
+// ParseEgressRule parses the short form of an egress rule, a host or CIDR optionally followed by a port, for example
+// "api.example.com:443" or "10.0.0.0/8:5432"
+func ParseEgressRule(s string) (result EgressRule, _ error) {
+	dest := s
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		if port, err := strconv.ParseInt(s[i+1:], 10, 32); err == nil {
+			dest = s[:i]
+			result.Ports = []int32{int32(port)}
+		}
+	}
+
+	if dest == "" {
+		return result, fmt.Errorf("invalid egress rule %q: missing host or CIDR", s)
+	}
+	if strings.Contains(dest, "/") {
+		result.CIDRs = []string{dest}
+	} else {
+		result.Hosts = []string{dest}
+	}
+	return result, nil
+}
+
+func (in *TopologySpread) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		s, err := parseString(data)
+		if err != nil {
+			return err
+		}
+		in.Topology = s
+		return nil
+	}
+
+	type topologySpread TopologySpread
+	return json.Unmarshal(data, (*topologySpread)(in))
+}
+
+func (in *TopologySpreads) UnmarshalJSON(data []byte) error {
+	if !isArray(data) {
+		var spread TopologySpread
+		if err := json.Unmarshal(data, &spread); err != nil {
+			return err
+		}
+		*in = TopologySpreads{spread}
+		return nil
+	}
+
+	var spreads []TopologySpread
+	if err := json.Unmarshal(data, &spreads); err != nil {
+		return err
+	}
+	*in = spreads
+	return nil
+}
+
 func (in *Dependency) UnmarshalJSON(data []byte) error {
 	if isString(data) {
 		s, err := parseString(data)
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -47,3 +48,18 @@ func TestParseEnv(t *testing.T) {
 		Value: "y111",
 	}, f[1])
 }
+
+func TestTopologySpreadsUnmarshalJSON(t *testing.T) {
+	var con Container
+	if err := json.Unmarshal([]byte(`{"spread": "zone", "maxUnavailable": "25%"}`), &con); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, TopologySpreads{{Topology: "zone"}}, con.Spread)
+	assert.Equal(t, "25%", con.MaxUnavailable.String())
+
+	if err := json.Unmarshal([]byte(`{"spread": ["zone", {"topology": "node", "maxSkew": 2, "required": true}], "maxUnavailable": 1}`), &con); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, TopologySpreads{{Topology: "zone"}, {Topology: "node", MaxSkew: 2, Required: true}}, con.Spread)
+	assert.Equal(t, 1, con.MaxUnavailable.IntValue())
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	UpgradeDecisionDeferred  = "Deferred"
+	UpgradeDecisionApplied   = "Applied"
+	UpgradeDecisionSucceeded = "Succeeded"
+	UpgradeDecisionReverted  = "Reverted"
+)
+
+// UpgradePolicy controls when automatic upgrades of an app are applied and whether they are kept
+type UpgradePolicy struct {
+	// Windows are the times automatic upgrades may be applied. If empty, upgrades may be applied at any time.
+	Windows []UpgradeWindow `json:"windows,omitempty"`
+	// SoakPeriod is how long an upgraded app must stay ready before the upgrade is kept. If the app doesn't become
+	// ready or stops being ready during this period, the previous image is restored.
+	SoakPeriod string `json:"soakPeriod,omitempty"`
+}
+
+// UpgradeWindow is a recurring time window using the cron syntax for the day of week and hour fields
+type UpgradeWindow struct {
+	// Days is a cron day of week field, like "*", "1-5" or "sat,sun"
+	Days string `json:"days,omitempty"`
+	// Hours is a cron hour field, like "*", "2-4" or "22,23"
+	Hours string `json:"hours,omitempty"`
+	// TimeZone is an IANA time zone name, like "America/New_York". The default is UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+func (in UpgradeWindow) String() string {
+	result := in.Days + ":" + in.Hours
+	if in.TimeZone != "" {
+		result += ":" + in.TimeZone
+	}
+	return result
+}
+
+// UpgradeSoak tracks an upgrade that is in its soak period
+type UpgradeSoak struct {
+	PreviousAppImage AppImage     `json:"previousAppImage,omitempty"`
+	Started          metav1.Time  `json:"started,omitempty"`
+	ReadySince       *metav1.Time `json:"readySince,omitempty"`
+}
+
+// UpgradeDecision records a decision made about an automatic upgrade of an app
+type UpgradeDecision struct {
+	Time     metav1.Time `json:"time,omitempty"`
+	Decision string      `json:"decision,omitempty"`
+	Image    string      `json:"image,omitempty"`
+	Digest   string      `json:"digest,omitempty"`
+	Message  string      `json:"message,omitempty"`
+}
+
+// ParseUpgradeWindows parses windows in the format days:hours[:timezone], for example "mon-fri:2-4:America/Chicago"
+func ParseUpgradeWindows(windows []string) (result []UpgradeWindow, _ error) {
+	for _, window := range windows {
+		parts := strings.SplitN(window, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid upgrade window %q, must be in the format days:hours[:timezone]", window)
+		}
+		w := UpgradeWindow{
+			Days:  parts[0],
+			Hours: parts[1],
+		}
+		if len(parts) == 3 {
+			w.TimeZone = parts[2]
+		}
+		result = append(result, w)
+	}
+	return
+}
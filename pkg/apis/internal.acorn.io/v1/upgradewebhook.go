@@ -0,0 +1,59 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	UpgradeWebhookFormatJSON  = "json"
+	UpgradeWebhookFormatSlack = "slack"
+
+	// UpgradeEventAvailable is sent when a new image is found for an app that is configured for auto-upgrades
+	UpgradeEventAvailable = "upgrade.available"
+	// UpgradeEventApplied is sent when an app is upgraded to a new image
+	UpgradeEventApplied = "upgrade.applied"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type UpgradeWebhookInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UpgradeWebhookSpec   `json:"spec,omitempty"`
+	Status UpgradeWebhookStatus `json:"status,omitempty"`
+}
+
+type UpgradeWebhookSpec struct {
+	// URL is the http or https endpoint that events are posted to
+	URL string `json:"url,omitempty"`
+	// Format is the shape of the payload, either json (the default) or slack
+	Format string `json:"format,omitempty"`
+	// SecretName is the name of a secret in the project whose "secret" key is used to sign payloads with HMAC-SHA256
+	SecretName string `json:"secretName,omitempty"`
+	// Events are the events sent to the webhook. If empty, all events are sent.
+	Events []string `json:"events,omitempty"`
+}
+
+type UpgradeWebhookStatus struct {
+	// Deliveries are the most recent deliveries to the webhook, oldest first
+	Deliveries []UpgradeWebhookDelivery `json:"deliveries,omitempty"`
+}
+
+type UpgradeWebhookDelivery struct {
+	Time       metav1.Time `json:"time,omitempty"`
+	Event      string      `json:"event,omitempty"`
+	AppName    string      `json:"appName,omitempty"`
+	Image      string      `json:"image,omitempty"`
+	Attempts   int         `json:"attempts,omitempty"`
+	StatusCode int         `json:"statusCode,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type UpgradeWebhookInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradeWebhookInstance `json:"items"`
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterImagePolicyInstance ProjectImagePolicyInstance
+
+func (in *ClusterImagePolicyInstance) NamespaceScoped() bool {
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterImagePolicyInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterImagePolicyInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectImagePolicyInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Description string `json:"description,omitempty"`
+	// PublicKeys is a list of PEM encoded public keys. If any keys are set, images must have a signature that
+	// verifies against at least one of the keys before they can be run.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// AllowedImages is a list of image patterns. If any policy has allowed images, only images matching at least
+	// one pattern can be run.
+	AllowedImages []string `json:"allowedImages,omitempty"`
+	// DeniedImages is a list of image patterns. Images matching any pattern can not be run.
+	DeniedImages []string `json:"deniedImages,omitempty"`
+	// VulnerabilityThreshold is the lowest vulnerability severity (critical, high, medium, or low) that blocks an
+	// image from being run. Images are scanned by the vulnerability scanner configured for the cluster.
+	VulnerabilityThreshold string `json:"vulnerabilityThreshold,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectImagePolicyInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectImagePolicyInstance `json:"items"`
+}
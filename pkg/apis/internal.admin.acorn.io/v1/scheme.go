@@ -23,7 +23,11 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&ClusterComputeClassInstance{},
 		&ClusterComputeClassInstanceList{},
 		&ProjectComputeClassInstance{},
-		&ProjectComputeClassInstanceList{})
+		&ProjectComputeClassInstanceList{},
+		&ProjectImagePolicyInstance{},
+		&ProjectImagePolicyInstanceList{},
+		&ClusterImagePolicyInstance{},
+		&ClusterImagePolicyInstanceList{})
 
 	// Add common types
 	scheme.AddKnownTypes(SchemeGroupVersion, &metav1.Status{})
@@ -103,7 +103,7 @@ func (a *AppDefinition) YAML() (string, error) {
 
 func (a *AppDefinition) JSON() (string, error) {
 	appSpec := &v1.AppSpec{}
-	if err := a.newDecoder().Decode(&appSpec); err != nil {
+	if err := a.decode(&appSpec); err != nil {
 		return "", err
 	}
 	app, err := json.MarshalIndent(appSpec, "", "  ")
@@ -119,7 +119,7 @@ func (a *AppDefinition) newDecoder() *aml.Decoder {
 
 func (a *AppDefinition) AppSpec() (*v1.AppSpec, error) {
 	spec := &v1.AppSpec{}
-	if err := a.newDecoder().Decode(spec); err != nil {
+	if err := a.decode(spec); err != nil {
 		return nil, err
 	}
 
@@ -198,7 +198,7 @@ func (a *AppDefinition) WatchFiles(cwd string) (result []string, _ error) {
 
 func (a *AppDefinition) BuilderSpec() (*v1.BuilderSpec, error) {
 	spec := &v1.BuilderSpec{}
-	return spec, a.newDecoder().Decode(spec)
+	return spec, a.decode(spec)
 }
 
 func AppImageFromTar(reader io.Reader) (*v1.AppImage, error) {
@@ -239,5 +239,6 @@ func AppImageFromTar(reader io.Reader) (*v1.AppImage, error) {
 		return nil, fmt.Errorf("invalid image no Acornfile found")
 	}
 
+	result.Platforms = result.ImageData.Platforms()
 	return result, nil
 }
@@ -12,6 +12,7 @@ import (
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestParseRouters(t *testing.T) {
@@ -2276,3 +2277,278 @@ func TestNestedScopedLabels(t *testing.T) {
 	}}`))
 	assert.Error(t, err)
 }
+
+func TestPlatforms(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+platforms: ["linux/amd64", "linux/arm/v7"]
+containers: web: build: {
+	context: "."
+	platforms: ["linux/arm64"]
+}
+images: tool: build: platforms: ["linux/amd64"]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buildSpec, err := appDef.BuilderSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []v1.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+	}, buildSpec.Platforms)
+	assert.Equal(t, []v1.Platform{{OS: "linux", Architecture: "arm64"}}, buildSpec.Containers["web"].Build.Platforms)
+	assert.Equal(t, []v1.Platform{{OS: "linux", Architecture: "amd64"}}, buildSpec.Images["tool"].Build.Platforms)
+
+	_, err = NewAppDefinition([]byte(`platforms: ["amd64"]`))
+	assert.Error(t, err)
+}
+
+func TestDevSync(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: web: {
+	build: "."
+	dev: sync: {
+		paths: "/app": "./src"
+		ignore: ["*.pyc"]
+		command: "touch /app/reload"
+		signal: "HUP"
+	}
+	sidecars: watcher: {
+		image: "watcher"
+		dev: sync: paths: "/data": "./data"
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.Dev{
+		Sync: &v1.DevSync{
+			Paths:   map[string]string{"/app": "./src"},
+			Ignore:  []string{"*.pyc"},
+			Command: v1.CommandSlice{"touch", "/app/reload"},
+			Signal:  "HUP",
+		},
+	}, appSpec.Containers["web"].Dev)
+	assert.Equal(t, map[string]string{"/data": "./data"}, appSpec.Containers["web"].Sidecars["watcher"].Dev.Sync.Paths)
+}
+
+func TestDevDebug(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: api: {
+	build: "."
+	dev: debug: {
+		port: 2345
+		command: ["dlv", "exec", "/app/server", "--headless", "--listen=:2345"]
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.Dev{
+		Debug: &v1.DevDebug{
+			Port:    2345,
+			Command: v1.CommandSlice{"dlv", "exec", "/app/server", "--headless", "--listen=:2345"},
+		},
+	}, appSpec.Containers["api"].Dev)
+
+	_, err = NewAppDefinition([]byte(`containers: api: {image: "api", dev: debug: port: 0}`))
+	assert.Error(t, err)
+}
+
+func TestEgress(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+egress: ["*.example.com:443"]
+containers: web: {
+	image: "web"
+	egress: [
+		"10.20.0.0/16:5432",
+		{
+			hosts: ["smtp.example.com"]
+			ports: [25, 587]
+		},
+	]
+	sidecars: proxy: {
+		image: "proxy"
+		egress: [{cidrs: ["10.0.0.0/24"], protocol: "udp"}]
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []v1.EgressRule{{Hosts: []string{"*.example.com"}, Ports: []int32{443}}}, appSpec.Egress)
+	assert.Equal(t, []v1.EgressRule{
+		{CIDRs: []string{"10.20.0.0/16"}, Ports: []int32{5432}},
+		{Hosts: []string{"smtp.example.com"}, Ports: []int32{25, 587}},
+	}, appSpec.Containers["web"].Egress)
+	assert.Equal(t, []v1.EgressRule{{CIDRs: []string{"10.0.0.0/24"}, Protocol: "udp"}}, appSpec.Containers["web"].Sidecars["proxy"].Egress)
+
+	_, err = NewAppDefinition([]byte(`egress: [{hosts: ["example.com"], ports: [0]}]`))
+	assert.Error(t, err)
+}
+
+func TestSecurityContext(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: web: {
+	image: "nginx"
+	securityContext: {
+		runAsUser: 1000
+		runAsGroup: 1000
+		runAsNonRoot: true
+		readOnlyRootFilesystem: true
+		capabilities: {
+			drop: ["ALL"]
+			add: ["NET_BIND_SERVICE"]
+		}
+		seccompProfile: "RuntimeDefault"
+	}
+	sidecars: proxy: {
+		image: "proxy"
+		securityContext: seccompProfile: "localhost/profiles/proxy.json"
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.SecurityContext{
+		RunAsUser:              &[]int64{1000}[0],
+		RunAsGroup:             &[]int64{1000}[0],
+		RunAsNonRoot:           &[]bool{true}[0],
+		ReadOnlyRootFilesystem: &[]bool{true}[0],
+		Capabilities: &v1.Capabilities{
+			Add:  []string{"NET_BIND_SERVICE"},
+			Drop: []string{"ALL"},
+		},
+		SeccompProfile: "RuntimeDefault",
+	}, appSpec.Containers["web"].SecurityContext)
+	assert.Equal(t, "localhost/profiles/proxy.json", appSpec.Containers["web"].Sidecars["proxy"].SecurityContext.SeccompProfile)
+
+	_, err = NewAppDefinition([]byte(`containers: web: {image: "nginx", securityContext: seccompProfile: "Other"}`))
+	assert.Error(t, err)
+}
+
+func TestAvailability(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: {
+	web: {
+		image: "nginx"
+		scale: 6
+		maxUnavailable: 1
+		spread: [
+			"zone",
+			{
+				topology: "node"
+				maxSkew: 2
+				required: true
+			},
+		]
+	}
+	api: {
+		image: "api"
+		scale: 4
+		maxUnavailable: "25%"
+		spread: "node"
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := appSpec.Containers["web"]
+	assert.Equal(t, &[]intstr.IntOrString{intstr.FromInt(1)}[0], web.MaxUnavailable)
+	assert.Equal(t, v1.TopologySpreads{
+		{Topology: "zone"},
+		{Topology: "node", MaxSkew: 2, Required: true},
+	}, web.Spread)
+
+	api := appSpec.Containers["api"]
+	assert.Equal(t, &[]intstr.IntOrString{intstr.FromString("25%")}[0], api.MaxUnavailable)
+	assert.Equal(t, v1.TopologySpreads{{Topology: "node"}}, api.Spread)
+
+	_, err = NewAppDefinition([]byte(`containers: web: {image: "nginx", maxUnavailable: "half"}`))
+	assert.Error(t, err)
+}
+
+func TestStateful(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: db: {
+	image: "postgres"
+	scale: 3
+	stateful: true
+	dirs: "/var/lib/postgresql/data": "data"
+}
+volumes: data: size: "10G"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, appSpec.Containers["db"].Stateful)
+
+	_, err = NewAppDefinition([]byte(`jobs: backup: {image: "backup", stateful: true}`))
+	assert.Error(t, err)
+}
+
+func TestPerNode(t *testing.T) {
+	appDef, err := NewAppDefinition([]byte(`
+containers: "log-collector": {
+	image: "fluent/fluent-bit"
+	perNode: true
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, appSpec.Containers["log-collector"].PerNode)
+
+	_, err = NewAppDefinition([]byte(`jobs: backup: {image: "backup", perNode: true}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package appdefinition
+
+import (
+	"encoding/json"
+
+	cuelang "cuelang.org/go/cue"
+	"github.com/acorn-io/acorn/pkg/appdefinition/schema"
+	cue_mod "github.com/acorn-io/aml/cue.mod"
+	"github.com/acorn-io/aml/pkg/cue"
+	"github.com/acorn-io/aml/pkg/definition"
+	amlschema "github.com/acorn-io/aml/schema"
+)
+
+// topLevelKeys are the keys of an Acornfile that are decoded into the spec.
+var topLevelKeys = []string{
+	"containers",
+	"jobs",
+	"acorns",
+	"secrets",
+	"volumes",
+	"images",
+	"routers",
+	"labels",
+	"annotations",
+	"platforms",
+	"egress",
+}
+
+func init() {
+	// The aml decoder validates Acornfiles against the schema it embeds when
+	// computing args, so point it at the acorn schema.
+	amlschema.Files = schema.Files
+}
+
+// decode decodes the Acornfile into spec. It follows the aml decoder, which
+// drops top level keys it does not know about.
+func (a *AppDefinition) decode(spec any) error {
+	args, err := a.newDecoder().ComputedArgs()
+	if err != nil {
+		return err
+	}
+
+	ctx := cue.NewContext().
+		WithNestedFS("schema", schema.Files).
+		WithNestedFS("cue.mod", cue_mod.Files).
+		WithFiles(definition.NewAcornfile(a.data)...).
+		WithSchema(definition.Schema, definition.AppType)
+	if len(args) > 0 {
+		data, err := json.Marshal(map[string]any{
+			"args": args,
+		})
+		if err != nil {
+			return err
+		}
+		ctx = ctx.WithFile("args.cue", data)
+	}
+
+	app, err := ctx.Value()
+	if err != nil {
+		return err
+	}
+
+	objs := map[string]any{}
+	for _, key := range topLevelKeys {
+		v := app.LookupPath(cuelang.ParsePath(key))
+		if v.Exists() {
+			objs[key] = v
+		}
+	}
+
+	newApp, err := ctx.Encode(objs)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Decode(newApp, spec)
+}
@@ -0,0 +1,10 @@
+package schema
+
+import "embed"
+
+// Files holds the Acornfile schema. It is the schema of the aml module
+// extended with the fields acorn supports beyond it, and is laid out the same
+// way so it can replace the aml schema.
+//
+//go:embed v1
+var Files embed.FS
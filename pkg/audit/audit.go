@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/sirupsen/logrus"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// recentEntries is the number of entries that are kept in memory to be queried through the API
+	recentEntries = 1000
+	// reloadInterval is how often the sinks are rebuilt from the config
+	reloadInterval = 30 * time.Second
+)
+
+// sinkConfig is the part of the config that the sinks are built from
+type sinkConfig struct {
+	file       string
+	maxSize    int
+	maxBackups int
+	webhookURL string
+}
+
+// Recorder records audit entries. Every entry is kept in a fixed size buffer of recent entries and written to the
+// sinks that are enabled in the config.
+type Recorder struct {
+	client kclient.Reader
+
+	lock    sync.RWMutex
+	entries []apiv1.AuditEntry
+	next    int
+	sinks   []Sink
+	config  sinkConfig
+}
+
+func NewRecorder(c kclient.Reader) *Recorder {
+	return &Recorder{
+		client: c,
+	}
+}
+
+// Start keeps the sinks of the recorder in sync with the config until the context is done
+func (r *Recorder) Start(ctx context.Context) {
+	go func() {
+		for {
+			if err := r.reload(ctx); err != nil {
+				logrus.Errorf("Failed to configure audit sinks: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				r.setSinks(sinkConfig{}, nil)
+				return
+			case <-time.After(reloadInterval):
+			}
+		}
+	}()
+}
+
+func (r *Recorder) reload(ctx context.Context) error {
+	cfg, err := config.Get(ctx, r.client)
+	if err != nil {
+		return err
+	}
+
+	newConfig := sinkConfig{
+		file:       *cfg.AuditLogFile,
+		maxSize:    *cfg.AuditLogMaxSize,
+		maxBackups: *cfg.AuditLogMaxBackups,
+		webhookURL: *cfg.AuditWebhookURL,
+	}
+
+	r.lock.RLock()
+	unchanged := newConfig == r.config
+	r.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	var sinks []Sink
+	if newConfig.file != "" {
+		sinks = append(sinks, NewFileSink(newConfig.file, newConfig.maxSize, newConfig.maxBackups))
+	}
+	if newConfig.webhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(newConfig.webhookURL))
+	}
+	r.setSinks(newConfig, sinks)
+	return nil
+}
+
+// setSinks replaces the sinks of the recorder and closes the sinks that are replaced
+func (r *Recorder) setSinks(cfg sinkConfig, sinks []Sink) {
+	r.lock.Lock()
+	old := r.sinks
+	r.sinks, r.config = sinks, cfg
+	r.lock.Unlock()
+
+	for _, sink := range old {
+		if err := sink.Close(); err != nil {
+			logrus.Errorf("Failed to close audit sink: %v", err)
+		}
+	}
+}
+
+// Record adds the entry to the recent entries and writes it to the sinks. Failures of the sinks are logged, they
+// never fail the request that is audited.
+func (r *Recorder) Record(entry *apiv1.AuditEntry) {
+	r.lock.Lock()
+	if len(r.entries) < recentEntries {
+		r.entries = append(r.entries, *entry)
+	} else {
+		r.entries[r.next] = *entry
+	}
+	r.next = (r.next + 1) % recentEntries
+	sinks := r.sinks
+	r.lock.Unlock()
+
+	for _, sink := range sinks {
+		if err := sink.Write(entry); err != nil {
+			logrus.Errorf("Failed to write audit entry %s: %v", entry.Name, err)
+		}
+	}
+}
+
+// Recent returns the recent entries of the project, oldest first. The entries of all projects are returned if the
+// project is empty.
+func (r *Recorder) Recent(project string) []apiv1.AuditEntry {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := make([]apiv1.AuditEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		if project == "" || entry.Namespace == project {
+			result = append(result, *entry.DeepCopy())
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreationTimestamp.Before(&result[j].CreationTimestamp)
+	})
+	return result
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type memorySink struct {
+	entries []string
+	closed  bool
+}
+
+func (m *memorySink) Write(entry *apiv1.AuditEntry) error {
+	m.entries = append(m.entries, entry.Name)
+	return nil
+}
+
+func (m *memorySink) Close() error {
+	m.closed = true
+	return nil
+}
+
+func entry(name, project string, created time.Time) *apiv1.AuditEntry {
+	return &apiv1.AuditEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         project,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+}
+
+func names(entries []apiv1.AuditEntry) (result []string) {
+	for _, entry := range entries {
+		result = append(result, entry.Name)
+	}
+	return result
+}
+
+func TestRecent(t *testing.T) {
+	r := NewRecorder(nil)
+	sink := &memorySink{}
+	r.setSinks(sinkConfig{file: "audit.log"}, []Sink{sink})
+
+	now := time.Now()
+	r.Record(entry("b", "acorn", now.Add(time.Second)))
+	r.Record(entry("a", "acorn", now))
+	r.Record(entry("c", "other", now.Add(2*time.Second)))
+
+	assert.Equal(t, []string{"a", "b", "c"}, names(r.Recent("")))
+	assert.Equal(t, []string{"a", "b"}, names(r.Recent("acorn")))
+	assert.Empty(t, r.Recent("missing"))
+	assert.Equal(t, []string{"b", "a", "c"}, sink.entries)
+
+	r.setSinks(sinkConfig{}, nil)
+	assert.True(t, sink.closed)
+}
+
+func TestRecentKeepsNewestEntries(t *testing.T) {
+	r := NewRecorder(nil)
+
+	now := time.Now()
+	for i := 0; i < recentEntries+10; i++ {
+		r.Record(entry(fmt.Sprint(i), "acorn", now.Add(time.Duration(i)*time.Second)))
+	}
+
+	recent := r.Recent("")
+	assert.Len(t, recent, recentEntries)
+	assert.Equal(t, "10", recent[0].Name)
+	assert.Equal(t, fmt.Sprint(recentEntries+9), recent[len(recent)-1].Name)
+}
@@ -0,0 +1,304 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	adminapi "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io"
+	api "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/endpoints/responsewriter"
+)
+
+const (
+	// maxBodySize is the size of the request body that is read to build the summary of the request
+	maxBodySize = 64 * 1024
+	// maxSummarySize is the maximum length of the summary of a request
+	maxSummarySize = 1024
+	redacted       = "REDACTED"
+)
+
+var (
+	auditedVerbs = sets.NewString("create", "update", "patch", "delete", "deletecollection")
+	// auditedSubresources are audited for every verb, they reveal secrets or give access to running containers
+	auditedSubresources = sets.NewString("secrets/reveal", "containerreplicas/exec", "containerreplicas/portforward")
+	// ignoredSubresources are created to read data and do not change anything
+	ignoredSubresources = sets.NewString("images/details", "builders/port")
+	redactedKeys        = sets.NewString("data", "stringData", "password", "token")
+	// redactedPaths are the positions of values that hold user input that can be secret whatever its key is: the deploy
+	// args and environment variables of apps and the build args of images. A * matches any key or index.
+	redactedPaths = [][]string{
+		{"spec", "deployArgs", "*"},
+		{"spec", "environment", "*", "value"},
+		{"spec", "args", "*"},
+	}
+)
+
+// Wrap returns a handler that records an audit entry for each request of a user that changes an Acorn resource,
+// reveals a secret, or connects to a container. The handler must be installed after the request info and the user
+// are added to the context of the request.
+func (r *Recorder) Wrap(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		entry := newEntry(req)
+		if entry == nil {
+			handler.ServeHTTP(rw, req)
+			return
+		}
+
+		// Connections to containers can be open for a long time, record them when they start
+		if httpstream.IsUpgradeRequest(req) {
+			entry.Request = summarizeQuery(req)
+			r.Record(entry)
+			handler.ServeHTTP(rw, req)
+			return
+		}
+
+		entry.Request = summarize(req)
+		recorder := &statusRecorder{
+			ResponseWriter: rw,
+			status:         http.StatusOK,
+		}
+		defer func() {
+			entry.StatusCode = recorder.status
+			r.Record(entry)
+		}()
+		handler.ServeHTTP(responsewriter.WrapForHTTP1Or2(recorder), req)
+	})
+}
+
+// newEntry returns the entry for the request without the request summary, or nil if the request is not audited
+func newEntry(req *http.Request) *apiv1.AuditEntry {
+	info, ok := request.RequestInfoFrom(req.Context())
+	if !ok || !info.IsResourceRequest || (info.APIGroup != api.Group && info.APIGroup != adminapi.Group) {
+		return nil
+	}
+
+	resource := info.Resource
+	if info.Subresource != "" {
+		resource += "/" + info.Subresource
+	}
+	if ignoredSubresources.Has(resource) || (!auditedVerbs.Has(info.Verb) && !auditedSubresources.Has(resource)) {
+		return nil
+	}
+
+	entry := &apiv1.AuditEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              uuid.New().String(),
+			Namespace:         info.Namespace,
+			CreationTimestamp: metav1.Now(),
+		},
+		Verb:         info.Verb,
+		APIGroup:     info.APIGroup,
+		Resource:     info.Resource,
+		Subresource:  info.Subresource,
+		ResourceName: info.Name,
+		Project:      info.Namespace,
+	}
+
+	if user, ok := request.UserFrom(req.Context()); ok {
+		entry.User = user.GetName()
+		entry.Groups = user.GetGroups()
+	}
+	for _, ip := range utilnet.SourceIPs(req) {
+		entry.SourceIPs = append(entry.SourceIPs, ip.String())
+	}
+
+	switch info.Resource {
+	case "projects":
+		entry.Project = info.Name
+		entry.Namespace = info.Name
+	case "apps":
+		entry.App = info.Name
+	case "containerreplicas", "secrets", "volumes":
+		// The public names of these resources are prefixed by the name of the app
+		if app, _, ok := strings.Cut(info.Name, "."); ok {
+			entry.App = app
+		}
+	}
+
+	return entry
+}
+
+// summarize returns a summary of the body of the request with secret values redacted, or the query of the request if
+// it has no body. The body of the request is restored so that it can still be read by the handler.
+func summarize(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return summarizeQuery(req)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(body), req.Body),
+		Closer: req.Body,
+	}
+	if err != nil || len(body) == 0 {
+		return summarizeQuery(req)
+	}
+
+	return Summary(body)
+}
+
+func summarizeQuery(req *http.Request) string {
+	return truncate(req.URL.Query().Encode())
+}
+
+// Summary returns a compact form of the JSON document with the status and all metadata besides the name removed and
+// secret values redacted
+func Summary(body []byte) string {
+	var obj interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return "<unparsable body>"
+	}
+
+	if m, ok := obj.(map[string]interface{}); ok {
+		delete(m, "status")
+		delete(m, "kind")
+		delete(m, "apiVersion")
+		if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+			if name, ok := metadata["name"]; ok {
+				m["metadata"] = map[string]interface{}{"name": name}
+			} else {
+				delete(m, "metadata")
+			}
+		}
+	}
+
+	data, err := json.Marshal(Redact(obj))
+	if err != nil {
+		return "<unparsable body>"
+	}
+	return truncate(string(data))
+}
+
+// Redact replaces the values of all keys in the object that can hold secret values and the values at positions of the
+// object that hold secret values. The object can also be a JSON patch, then the values of its operations are redacted
+// by the path they are applied to.
+func Redact(obj interface{}) interface{} {
+	if ops, ok := jsonPatch(obj); ok {
+		for _, op := range ops {
+			if value, ok := op["value"]; ok {
+				path, _ := op["path"].(string)
+				op["value"] = redact(value, pointerPath(path))
+			}
+		}
+		return obj
+	}
+	return redact(obj, nil)
+}
+
+func redact(obj interface{}, path []string) interface{} {
+	if isSecretPath(path) {
+		return redacted
+	}
+
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSecretKey(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redact(value, append(path[:len(path):len(path)], key))
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redact(value, append(path[:len(path):len(path)], strconv.Itoa(i)))
+		}
+	}
+	return obj
+}
+
+// jsonPatch returns the operations of the object if it is a JSON patch
+func jsonPatch(obj interface{}) ([]map[string]interface{}, bool) {
+	list, ok := obj.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	var ops []map[string]interface{}
+	for _, item := range list {
+		op, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if _, ok := op["op"]; !ok {
+			return nil, false
+		}
+		ops = append(ops, op)
+	}
+	return ops, true
+}
+
+// pointerPath splits a JSON pointer into the keys of the path
+func pointerPath(pointer string) (result []string) {
+	if pointer == "" {
+		return nil
+	}
+	for _, key := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		result = append(result, strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~"))
+	}
+	return result
+}
+
+// isSecretPath returns true if the value at the path is, or is inside of, a value that can be secret
+func isSecretPath(path []string) bool {
+	for _, secretPath := range redactedPaths {
+		if len(path) < len(secretPath) {
+			continue
+		}
+		matches := true
+		for i, key := range secretPath {
+			if key != "*" && key != path[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecretKey(key string) bool {
+	lower := strings.ToLower(key)
+	return redactedKeys.Has(key) || strings.Contains(lower, "password") || strings.Contains(lower, "token")
+}
+
+func truncate(s string) string {
+	if len(s) <= maxSummarySize {
+		return s
+	}
+	return s[:maxSummarySize] + "..."
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if !s.wroteHeader {
+		s.status = code
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
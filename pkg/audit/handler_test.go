@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "app",
+			body: `{"kind":"App","apiVersion":"api.acorn.io/v1","metadata":{"name":"my-app","labels":{"a":"b"}},"spec":{"image":"nginx","deployArgs":{"adminPassword":"hunter2","apiToken":"abc","size":3}},"status":{"ready":true}}`,
+			want: `{"metadata":{"name":"my-app"},"spec":{"deployArgs":{"adminPassword":"REDACTED","apiToken":"REDACTED","size":"REDACTED"},"image":"nginx"}}`,
+		},
+		{
+			name: "secret",
+			body: `{"metadata":{"name":"creds"},"type":"opaque","data":{"key":"dmFsdWU="},"stringData":{"key":"value"}}`,
+			want: `{"data":"REDACTED","metadata":{"name":"creds"},"stringData":"REDACTED","type":"opaque"}`,
+		},
+		{
+			name: "credential",
+			body: `{"serverAddress":"docker.io","username":"alice","password":"hunter2"}`,
+			want: `{"password":"REDACTED","serverAddress":"docker.io","username":"alice"}`,
+		},
+		{
+			name: "json patch",
+			body: `[{"op":"replace","path":"/spec/stop","value":true}]`,
+			want: `[{"op":"replace","path":"/spec/stop","value":true}]`,
+		},
+		{
+			name: "app environment and deploy args",
+			body: `{"metadata":{"name":"my-app"},"spec":{"environment":[{"name":"DB_URL","value":"postgres://admin:hunter2@db"}],"deployArgs":{"dbUrl":"postgres://admin:hunter2@db","replicas":3}}}`,
+			want: `{"metadata":{"name":"my-app"},"spec":{"deployArgs":{"dbUrl":"REDACTED","replicas":"REDACTED"},"environment":[{"name":"DB_URL","value":"REDACTED"}]}}`,
+		},
+		{
+			name: "build args",
+			body: `{"metadata":{"name":"build"},"spec":{"builderName":"default","args":{"githubKey":"ghp_abc"}}}`,
+			want: `{"metadata":{"name":"build"},"spec":{"args":{"githubKey":"REDACTED"},"builderName":"default"}}`,
+		},
+		{
+			name: "json patch of secret values",
+			body: `[{"op":"add","path":"/spec/environment/-","value":{"name":"KEY","value":"hunter2"}},{"op":"replace","path":"/spec/deployArgs","value":{"key":"hunter2"}},{"op":"replace","path":"/spec/deployArgs/key","value":"hunter2"}]`,
+			want: `[{"op":"add","path":"/spec/environment/-","value":{"name":"KEY","value":"REDACTED"}},{"op":"replace","path":"/spec/deployArgs","value":{"key":"REDACTED"}},{"op":"replace","path":"/spec/deployArgs/key","value":"REDACTED"}]`,
+		},
+		{
+			name: "not json",
+			body: `spec: {}`,
+			want: `<unparsable body>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Summary([]byte(tt.body)))
+		})
+	}
+}
+
+func TestSummaryTruncated(t *testing.T) {
+	summary := Summary([]byte(`{"value":"` + strings.Repeat("a", 2*maxSummarySize) + `"}`))
+	assert.Len(t, summary, maxSummarySize+len("..."))
+	assert.True(t, strings.HasSuffix(summary, "..."))
+}
+
+func serve(t *testing.T, r *Recorder, req *http.Request, info *request.RequestInfo) *httptest.ResponseRecorder {
+	t.Helper()
+	ctx := request.WithRequestInfo(req.Context(), info)
+	ctx = request.WithUser(ctx, &user.DefaultInfo{Name: "alice", Groups: []string{"developers"}})
+
+	rw := httptest.NewRecorder()
+	r.Wrap(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// The handler must still be able to read the complete body
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		if len(body) > 0 {
+			assert.Contains(t, string(body), "hunter2")
+		}
+		rw.WriteHeader(http.StatusCreated)
+	})).ServeHTTP(rw, req.WithContext(ctx))
+	return rw
+}
+
+func TestWrap(t *testing.T) {
+	r := NewRecorder(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/apis/api.acorn.io/v1/namespaces/acorn/apps", strings.NewReader(`{"metadata":{"name":"my-app"},"spec":{"deployArgs":{"password":"hunter2"}}}`))
+	rw := serve(t, r, req, &request.RequestInfo{
+		IsResourceRequest: true,
+		Verb:              "create",
+		APIGroup:          "api.acorn.io",
+		Resource:          "apps",
+		Namespace:         "acorn",
+		Name:              "my-app",
+	})
+	assert.Equal(t, http.StatusCreated, rw.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/apis/api.acorn.io/v1/namespaces/acorn/secrets/my-app.db/reveal", nil)
+	serve(t, r, req, &request.RequestInfo{
+		IsResourceRequest: true,
+		Verb:              "get",
+		APIGroup:          "api.acorn.io",
+		Resource:          "secrets",
+		Subresource:       "reveal",
+		Namespace:         "acorn",
+		Name:              "my-app.db",
+	})
+
+	// Reads and requests for other groups are not audited
+	req = httptest.NewRequest(http.MethodGet, "/apis/api.acorn.io/v1/namespaces/acorn/apps/my-app", nil)
+	serve(t, r, req, &request.RequestInfo{
+		IsResourceRequest: true,
+		Verb:              "get",
+		APIGroup:          "api.acorn.io",
+		Resource:          "apps",
+		Namespace:         "acorn",
+		Name:              "my-app",
+	})
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/namespaces/acorn/pods/my-pod", nil)
+	serve(t, r, req, &request.RequestInfo{
+		IsResourceRequest: true,
+		Verb:              "delete",
+		Resource:          "pods",
+		Namespace:         "acorn",
+		Name:              "my-pod",
+	})
+
+	entries := r.Recent("acorn")
+	require.Len(t, entries, 2)
+
+	created := entries[0]
+	assert.Equal(t, "alice", created.User)
+	assert.Equal(t, []string{"developers"}, created.Groups)
+	assert.Equal(t, "create", created.Verb)
+	assert.Equal(t, "apps", created.Resource)
+	assert.Equal(t, "my-app", created.ResourceName)
+	assert.Equal(t, "acorn", created.Project)
+	assert.Equal(t, "my-app", created.App)
+	assert.Equal(t, http.StatusCreated, created.StatusCode)
+	assert.Equal(t, `{"metadata":{"name":"my-app"},"spec":{"deployArgs":{"password":"REDACTED"}}}`, created.Request)
+	assert.NotEmpty(t, created.Name)
+
+	revealed := entries[1]
+	assert.Equal(t, "secrets", revealed.Resource)
+	assert.Equal(t, "reveal", revealed.Subresource)
+	assert.Equal(t, "my-app", revealed.App)
+	assert.Empty(t, revealed.Request)
+}
@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Sink is a destination of audit entries
+type Sink interface {
+	Write(entry *apiv1.AuditEntry) error
+	Close() error
+}
+
+type fileSink struct {
+	lock   sync.Mutex
+	logger *lumberjack.Logger
+}
+
+// NewFileSink returns a sink that writes one JSON document per line to the file. The file is rotated when it reaches
+// maxSize megabytes and at most maxBackups rotated files are kept.
+func NewFileSink(file string, maxSize, maxBackups int) Sink {
+	return &fileSink{
+		logger: &lumberjack.Logger{
+			Filename:   file,
+			MaxSize:    maxSize,
+			MaxBackups: maxBackups,
+		},
+	}
+}
+
+func (f *fileSink) Write(entry *apiv1.AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, err = f.logger.Write(append(data, '\n'))
+	return err
+}
+
+func (f *fileSink) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.logger.Close()
+}
+
+const (
+	webhookQueueSize = 1000
+	webhookTimeout   = 10 * time.Second
+)
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan *apiv1.AuditEntry
+	cancel func()
+	done   chan struct{}
+}
+
+// NewWebhookSink returns a sink that posts each entry as JSON to the URL. Entries are sent in the background so that
+// a slow webhook does not slow down the API server, entries are dropped when too many are waiting to be sent.
+func NewWebhookSink(url string) Sink {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &webhookSink{
+		url: url,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+		queue:  make(chan *apiv1.AuditEntry, webhookQueueSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go w.run(ctx)
+	return w
+}
+
+func (w *webhookSink) run(ctx context.Context) {
+	defer close(w.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-w.queue:
+			if err := w.send(ctx, entry); err != nil {
+				logrus.Errorf("Failed to send audit entry %s to webhook: %v", entry.Name, err)
+			}
+		}
+	}
+}
+
+func (w *webhookSink) send(ctx context.Context, entry *apiv1.AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (w *webhookSink) Write(entry *apiv1.AuditEntry) error {
+	select {
+	case w.queue <- entry:
+		return nil
+	default:
+		return fmt.Errorf("webhook queue is full, dropping entry")
+	}
+}
+
+func (w *webhookSink) Close() error {
+	w.cancel()
+	<-w.done
+	return nil
+}
@@ -2,12 +2,16 @@ package autoupgrade
 
 import (
 	"context"
+	"errors"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
 	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagescan"
 	tags2 "github.com/acorn-io/acorn/pkg/tags"
+	"github.com/acorn-io/acorn/pkg/upgradewebhook"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,12 +19,15 @@ import (
 
 type daemonClient interface {
 	getConfig(context.Context) (*apiv1.Config, error)
+	getConfigForProject(context.Context, string) (*apiv1.Config, error)
 	listAppInstances(context.Context) ([]v1.AppInstance, error)
 	updateAppStatus(context.Context, *v1.AppInstance) error
 	listTags(context.Context, string, string, ...remote.Option) ([]string, error)
 	getTagsMatchingRepo(context.Context, name.Reference, string, string) ([]string, error)
 	imageDigest(context.Context, string, string, ...remote.Option) (string, error)
 	resolveLocalTag(context.Context, string, string) (string, bool, error)
+	checkImageAllowed(context.Context, string, string, string) error
+	notify(context.Context, upgradewebhook.Event)
 }
 
 type client struct {
@@ -31,6 +38,10 @@ func (c *client) getConfig(ctx context.Context) (*apiv1.Config, error) {
 	return config.Get(ctx, c.client)
 }
 
+func (c *client) getConfigForProject(ctx context.Context, namespace string) (*apiv1.Config, error) {
+	return config.GetForProject(ctx, c.client, namespace)
+}
+
 func (c *client) listAppInstances(ctx context.Context) ([]v1.AppInstance, error) {
 	var appInstanceList v1.AppInstanceList
 	return appInstanceList.Items, c.client.List(ctx, &appInstanceList)
@@ -53,6 +64,22 @@ func (c *client) imageDigest(ctx context.Context, namespace, name string, opts .
 	return images.ImageDigest(ctx, c.client, namespace, name, opts...)
 }
 
+func (c *client) checkImageAllowed(ctx context.Context, namespace, image, digest string) error {
+	if err := imagepolicy.CheckImageAllowed(ctx, c.client, namespace, image); err != nil {
+		return err
+	}
+	// The app controller waits for a pending scan before it pulls the image
+	if err := imagescan.CheckImage(ctx, c.client, namespace, image, digest); err != nil && !errors.Is(err, imagescan.ErrScanPending) {
+		return err
+	}
+	return nil
+}
+
+func (c *client) notify(ctx context.Context, event upgradewebhook.Event) {
+	// Deliveries are retried, so don't hold up the daemon while they are sent
+	go upgradewebhook.Notify(ctx, c.client, event)
+}
+
 func (c *client) resolveLocalTag(ctx context.Context, namespace, name string) (string, bool, error) {
 	return tags2.ResolveLocal(ctx, c.client, namespace, name)
 }
@@ -7,6 +7,7 @@ import (
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/upgradewebhook"
 	"github.com/acorn-io/baaah/pkg/router"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/sirupsen/logrus"
@@ -33,6 +34,8 @@ func Sync() {
 type daemon struct {
 	client           daemonClient
 	appKeysPrevCheck map[kclient.ObjectKey]time.Time
+	// projectIntervals are the auto-upgrade intervals of the namespaces whose project overrides the interval of the config
+	projectIntervals map[string]time.Duration
 }
 
 func newDaemon(c kclient.Client) *daemon {
@@ -109,7 +112,7 @@ func (d *daemon) sync(ctx context.Context, now time.Time) (time.Duration, error)
 	// This loop does two things:
 	// 1. Builds a general purpose map (apps) of all returned apps for use throughout the function
 	// 2. Add any NEW apps with autoUpgrade turned on to the d.appKeysPrevCheck map with a next check time in the past
-	//    to ensure they'll be checked this sync
+	//    to ensure they'll be checked this sync. Apps that requested a check since their previous check are reset the same way.
 	apps := map[kclient.ObjectKey]v1.AppInstance{}
 	for _, app := range appInstances {
 		key := router.Key(app.Namespace, app.Name)
@@ -119,15 +122,20 @@ func (d *daemon) sync(ctx context.Context, now time.Time) (time.Duration, error)
 			if _, ok := d.appKeysPrevCheck[key]; !ok {
 				// If it's not in the map yet, we should check it on this run, so set the "previous check" to a time in the past
 				d.appKeysPrevCheck[key] = time.Time{}
+			} else if requested, ok := CheckRequested(app); ok && requested.After(d.appKeysPrevCheck[key]) {
+				// A check was requested, for example by a registry push notification, since the app was last checked
+				d.appKeysPrevCheck[key] = time.Time{}
 			}
 		}
 	}
 
+	d.projectIntervals = d.getProjectIntervals(ctx, apps, defaultNextCheckInterval)
 	d.refreshImages(ctx, apps, d.determineAppsToRefresh(apps, defaultNextCheckInterval, now), now)
 
 	nearestNextCheck := now.Add(defaultNextCheckInterval)
 	for appKey, prevCheck := range d.appKeysPrevCheck {
-		nextCheck, err := calcNextCheck(defaultNextCheckInterval, prevCheck, apps[appKey])
+		app := apps[appKey]
+		nextCheck, err := calcNextCheck(d.checkInterval(defaultNextCheckInterval, app), prevCheck, app)
 		if err == nil && nextCheck.Before(nearestNextCheck) {
 			nearestNextCheck = nextCheck
 		}
@@ -151,7 +159,7 @@ func (d *daemon) determineAppsToRefresh(apps map[kclient.ObjectKey]v1.AppInstanc
 			continue
 		}
 
-		nextCheck, err := calcNextCheck(defaultNextCheckInterval, prevCheckTime, app)
+		nextCheck, err := calcNextCheck(d.checkInterval(defaultNextCheckInterval, app), prevCheckTime, app)
 		if err != nil {
 			logrus.Errorf("Problem calculating next check time for app %v: %v", app.Name, err)
 			continue
@@ -223,12 +231,40 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 			}
 
 			if updated || strings.TrimPrefix(app.Status.AppImage.Digest, "sha256:") != strings.TrimPrefix(digest, "sha256:") {
+				if err := d.client.checkImageAllowed(ctx, app.Namespace, nextAppImage, digest); err != nil {
+					logrus.Warnf("Not upgrading app %v to %v: %v", appKey, nextAppImage, err)
+					d.appKeysPrevCheck[appKey] = updateTime
+					continue
+				}
+
 				mode, _ := Mode(app.Spec)
 				switch mode {
 				case "enabled":
 					if app.Status.AvailableAppImage == nextAppImage {
 						continue
 					}
+					if app.Status.UpgradeSoak != nil {
+						// Wait for the soak of the previous upgrade to finish before starting another one
+						d.appKeysPrevCheck[appKey] = updateTime
+						continue
+					}
+					if Reverted(app.Status, nextAppImage, digest) {
+						d.appKeysPrevCheck[appKey] = updateTime
+						continue
+					}
+					if inWindow, err := InWindow(app.Spec.UpgradePolicy, updateTime); err != nil {
+						logrus.Errorf("Problem checking upgrade windows of app %v: %v", appKey, err)
+						continue
+					} else if !inWindow {
+						logrus.Infof("Deferring auto-upgrade of app %v to %v until its next upgrade window", appKey, nextAppImage)
+						RecordDecision(&app.Status, v1.UpgradeDecisionDeferred, nextAppImage, digest, "outside of upgrade windows", updateTime)
+						if err := d.client.updateAppStatus(ctx, &app); err != nil {
+							logrus.Errorf("Problem updating %v: %v", appKey, err)
+							continue
+						}
+						d.appKeysPrevCheck[appKey] = updateTime
+						continue
+					}
 					app.Status.AvailableAppImage = nextAppImage
 					app.Status.ConfirmUpgradeAppImage = ""
 				case "notify":
@@ -252,6 +288,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					logrus.Errorf("Problem updating %v: %v", appKey, err)
 					continue
 				}
+				d.client.notify(ctx, upgradewebhook.NewEvent(v1.UpgradeEventAvailable, &app, nextAppImage, digest))
 			}
 
 			// This app was checked on this run, so update the prevCheckTime time for this app
@@ -260,6 +297,42 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 	}
 }
 
+// getProjectIntervals returns the auto-upgrade intervals of the namespaces of apps with auto-upgrade enabled whose
+// project overrides the interval of the config
+func (d *daemon) getProjectIntervals(ctx context.Context, apps map[kclient.ObjectKey]v1.AppInstance, defaultInterval time.Duration) map[string]time.Duration {
+	result := map[string]time.Duration{}
+	seen := map[string]bool{}
+	for _, app := range apps {
+		if _, ok := Mode(app.Spec); !ok || seen[app.Namespace] {
+			continue
+		}
+		seen[app.Namespace] = true
+
+		cfg, err := d.client.getConfigForProject(ctx, app.Namespace)
+		if err != nil {
+			logrus.Errorf("Problem getting the config of the project of namespace %s: %v", app.Namespace, err)
+			continue
+		}
+		interval, err := time.ParseDuration(*cfg.AutoUpgradeInterval)
+		if err != nil {
+			logrus.Warnf("Error parsing auto-upgrade interval %s of the project of namespace %s, using default of %s: %v", *cfg.AutoUpgradeInterval, app.Namespace, defaultInterval, err)
+			continue
+		}
+		if interval != defaultInterval {
+			result[app.Namespace] = interval
+		}
+	}
+	return result
+}
+
+// checkInterval returns the interval at which the app is checked for upgrades if it does not set its own interval
+func (d *daemon) checkInterval(defaultInterval time.Duration, app v1.AppInstance) time.Duration {
+	if interval, ok := d.projectIntervals[app.Namespace]; ok {
+		return interval
+	}
+	return defaultInterval
+}
+
 func calcNextCheck(defaultInterval time.Duration, lastUpdate time.Time, app v1.AppInstance) (time.Time, error) {
 	if app.CreationTimestamp.After(lastUpdate) {
 		// If the app was created after the last update time, then the app was deleted and recreated between sync runs.
@@ -296,7 +369,7 @@ func AutoUpgradePattern(image string) (string, bool) {
 		tag = parts[len(parts)-1]
 	}
 
-	return tag, strings.ContainsAny(tag, "#*")
+	return tag, strings.ContainsAny(tag, "#*") || IsSemverConstraint(tag)
 }
 
 func Mode(appSpec v1.AppInstanceSpec) (string, bool) {
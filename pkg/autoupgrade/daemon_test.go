@@ -10,6 +10,8 @@ import (
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	kclient "github.com/acorn-io/acorn/pkg/k8sclient"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/upgradewebhook"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -21,16 +23,26 @@ import (
 type mockDaemonClient struct {
 	apps                                []v1.AppInstance
 	defaultAutoUpgradeInterval          string
+	projectAutoUpgradeIntervals         map[string]string
 	appUpdates                          map[string]string
 	localTags, remoteTags               []string
 	remoteImageDigest, resolvedLocalTag string
 	localTagFound                       bool
+	deniedImages                        []string
+	notifications                       []upgradewebhook.Event
 }
 
 func (m *mockDaemonClient) getConfig(_ context.Context) (*apiv1.Config, error) {
 	return &apiv1.Config{AutoUpgradeInterval: &m.defaultAutoUpgradeInterval}, nil
 }
 
+func (m *mockDaemonClient) getConfigForProject(_ context.Context, namespace string) (*apiv1.Config, error) {
+	if interval, ok := m.projectAutoUpgradeIntervals[namespace]; ok {
+		return &apiv1.Config{AutoUpgradeInterval: &interval}, nil
+	}
+	return m.getConfig(context.Background())
+}
+
 func (m *mockDaemonClient) listAppInstances(_ context.Context) ([]v1.AppInstance, error) {
 	return m.apps, nil
 }
@@ -63,6 +75,19 @@ func (m *mockDaemonClient) resolveLocalTag(context.Context, string, string) (str
 	return m.resolvedLocalTag, m.localTagFound, nil
 }
 
+func (m *mockDaemonClient) checkImageAllowed(_ context.Context, _, image, _ string) error {
+	for _, denied := range m.deniedImages {
+		if denied == image {
+			return fmt.Errorf("image %s is denied", image)
+		}
+	}
+	return nil
+}
+
+func (m *mockDaemonClient) notify(_ context.Context, event upgradewebhook.Event) {
+	m.notifications = append(m.notifications, event)
+}
+
 func TestDetermineAppsToRefresh(t *testing.T) {
 	defaultNextCheckInterval := time.Minute
 	now := time.Now()
@@ -181,6 +206,8 @@ func TestRefreshImages(t *testing.T) {
 		"acorn-1":     "docker.io/acorn/acorn-1:v1.1.1-*",
 		"enabled-app": "docker.io/acorn/enabled:latest",
 		"notify-app":  "docker.io/acorn/notify:latest",
+		"window-app":  "docker.io/acorn/window:latest",
+		"soak-app":    "docker.io/acorn/soak:latest",
 	}
 	apps := make(map[kclient.ObjectKey]v1.AppInstance, len(appImages))
 	for _, entry := range typed.Sorted(appImages) {
@@ -194,6 +221,15 @@ func TestRefreshImages(t *testing.T) {
 			app.Spec.AutoUpgrade = ptrTrue
 		case "notify-app":
 			app.Spec.NotifyUpgrade = ptrTrue
+		case "window-app":
+			// Only allow upgrades tomorrow
+			app.Spec.AutoUpgrade = ptrTrue
+			app.Spec.UpgradePolicy = &v1.UpgradePolicy{
+				Windows: []v1.UpgradeWindow{{Days: fmt.Sprint((int(now.Weekday()) + 1) % 7), Hours: "*", TimeZone: now.Location().String()}},
+			}
+		case "soak-app":
+			app.Spec.AutoUpgrade = ptrTrue
+			app.Status.UpgradeSoak = &v1.UpgradeSoak{Started: metav1.NewTime(thirtySecondsAgo)}
 		}
 		apps[router.Key(app.Namespace, app.Name)] = app
 	}
@@ -233,6 +269,13 @@ func TestRefreshImages(t *testing.T) {
 			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "acorn-1"): now},
 			appsUpdated:            map[string]string{"acorn-1": "index.docker.io/acorn/acorn-1:v1.1.1-alpha"},
 		},
+		{
+			name:                   "Auto refresh tag with remote update denied by image policy",
+			client:                 &mockDaemonClient{remoteTags: []string{"v1.1.1-alpha"}, deniedImages: []string{"index.docker.io/acorn/acorn-1:v1.1.1-alpha"}},
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{router.Key("acorn", "acorn-1"): thirtySecondsAgo},
+			imagesToRefresh:        map[imageAndNamespaceKey][]kclient.ObjectKey{{image: "docker.io/acorn/acorn-1", namespace: "acorn"}: {router.Key("acorn", "acorn-1")}},
+			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "acorn-1"): now},
+		},
 		{
 			name:                   "Auto refresh tag with multiple remote updates",
 			client:                 &mockDaemonClient{remoteTags: []string{"v1.1.1-alpha", "v1.1.1-beta"}},
@@ -333,6 +376,21 @@ func TestRefreshImages(t *testing.T) {
 			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "notify-app"): now},
 			appsUpdated:            map[string]string{"notify-app": "docker.io/acorn/notify"},
 		},
+		{
+			name:                   "Auto refresh enabled outside of upgrade window is deferred",
+			client:                 &mockDaemonClient{remoteImageDigest: "sha256:acorn4321docker.io/acorn/windowdcba"},
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{router.Key("acorn", "window-app"): thirtySecondsAgo},
+			imagesToRefresh:        map[imageAndNamespaceKey][]kclient.ObjectKey{{image: "docker.io/acorn/window", namespace: "acorn"}: {router.Key("acorn", "window-app")}},
+			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "window-app"): now},
+			appsUpdated:            map[string]string{"window-app": ""},
+		},
+		{
+			name:                   "Auto refresh enabled waits for upgrade soak",
+			client:                 &mockDaemonClient{remoteImageDigest: "sha256:acorn4321docker.io/acorn/soakdcba"},
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{router.Key("acorn", "soak-app"): thirtySecondsAgo},
+			imagesToRefresh:        map[imageAndNamespaceKey][]kclient.ObjectKey{{image: "docker.io/acorn/soak", namespace: "acorn"}: {router.Key("acorn", "soak-app")}},
+			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "soak-app"): now},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -348,6 +406,19 @@ func TestRefreshImages(t *testing.T) {
 			for appName, image := range tt.appsUpdated {
 				assert.Equalf(t, image, tt.client.appUpdates[appName], "%s app doesn't have expected new version", appName)
 			}
+
+			var notified []string
+			for _, event := range tt.client.notifications {
+				assert.Equal(t, v1.UpgradeEventAvailable, event.Type)
+				notified = append(notified, event.App)
+			}
+			var upgraded []string
+			for appName, image := range tt.appsUpdated {
+				if image != "" {
+					upgraded = append(upgraded, appName)
+				}
+			}
+			assert.ElementsMatch(t, upgraded, notified, "different apps notified than upgraded")
 		})
 	}
 }
@@ -385,6 +456,16 @@ func TestDaemonSync(t *testing.T) {
 		apps = append(apps, app)
 	}
 
+	// The same apps, but a registry push notification requested a check of enabled-app after its previous check
+	twoMinutesAgo := time.Now().Add(-2 * time.Minute)
+	requestedApps := make([]v1.AppInstance, 0, len(apps))
+	for _, app := range apps {
+		if app.Name == "enabled-app" {
+			app.Annotations = map[string]string{labels.AcornUpgradeCheckRequested: fiftySecondsAgo.Format(time.RFC3339Nano)}
+		}
+		requestedApps = append(requestedApps, app)
+	}
+
 	tests := []struct {
 		name                                          string
 		apps                                          []v1.AppInstance
@@ -487,6 +568,46 @@ func TestDaemonSync(t *testing.T) {
 			},
 			expectedNextCheckInterval: 10 * time.Second,
 		},
+		{
+			name:                   "App with a requested check is refreshed before its interval",
+			apps:                   requestedApps,
+			defaultUpgradeInterval: "1h",
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{
+				router.Key("acorn", "test-1"):      start,
+				router.Key("acorn", "acorn-1"):     start,
+				router.Key("acorn", "enabled-app"): twoMinutesAgo,
+				router.Key("acorn", "notify-app"):  start,
+			},
+			appKeysPrevCheckAfter: map[kclient.ObjectKey]time.Time{
+				router.Key("acorn", "test-1"):      start,
+				router.Key("acorn", "acorn-1"):     start,
+				router.Key("acorn", "enabled-app"): start,
+				router.Key("acorn", "notify-app"):  start,
+				// Not able to calculate refresh interval, so app is not updated.
+				router.Key("acorn", "bad-interval"): {},
+			},
+			expectedNextCheckInterval: 30 * time.Second,
+		},
+		{
+			name:                   "App with a check requested before its previous check is not refreshed",
+			apps:                   requestedApps,
+			defaultUpgradeInterval: "1h",
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{
+				router.Key("acorn", "test-1"):      start,
+				router.Key("acorn", "acorn-1"):     start,
+				router.Key("acorn", "enabled-app"): start,
+				router.Key("acorn", "notify-app"):  start,
+			},
+			appKeysPrevCheckAfter: map[kclient.ObjectKey]time.Time{
+				router.Key("acorn", "test-1"):      start,
+				router.Key("acorn", "acorn-1"):     start,
+				router.Key("acorn", "enabled-app"): start,
+				router.Key("acorn", "notify-app"):  start,
+				// Not able to calculate refresh interval, so app is not updated.
+				router.Key("acorn", "bad-interval"): {},
+			},
+			expectedNextCheckInterval: 30 * time.Second,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -506,3 +627,33 @@ func TestDaemonSync(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectIntervals(t *testing.T) {
+	ptrTrue := &[]bool{true}[0]
+	apps := map[kclient.ObjectKey]v1.AppInstance{
+		router.Key("fast", "app"): {
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "fast"},
+			Spec:       v1.AppInstanceSpec{AutoUpgrade: ptrTrue},
+		},
+		router.Key("acorn", "app"): {
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"},
+			Spec:       v1.AppInstanceSpec{AutoUpgrade: ptrTrue},
+		},
+		router.Key("bad", "app"): {
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "bad"},
+			Spec:       v1.AppInstanceSpec{AutoUpgrade: ptrTrue},
+		},
+	}
+	d := &daemon{
+		client: &mockDaemonClient{
+			defaultAutoUpgradeInterval:  "1h",
+			projectAutoUpgradeIntervals: map[string]string{"fast": "1m", "bad": "help"},
+		},
+	}
+
+	d.projectIntervals = d.getProjectIntervals(context.Background(), apps, time.Hour)
+	assert.Equal(t, map[string]time.Duration{"fast": time.Minute}, d.projectIntervals)
+	assert.Equal(t, time.Minute, d.checkInterval(time.Hour, apps[router.Key("fast", "app")]))
+	assert.Equal(t, time.Hour, d.checkInterval(time.Hour, apps[router.Key("acorn", "app")]))
+	assert.Equal(t, time.Hour, d.checkInterval(time.Hour, apps[router.Key("bad", "app")]))
+}
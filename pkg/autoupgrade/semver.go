@@ -0,0 +1,228 @@
+package autoupgrade
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// semverConstraintChars are characters that can't appear in a docker tag, so their presence in an auto-upgrade
+// pattern means the pattern is a semantic version constraint rather than one of acorn's #, * and ** patterns.
+const semverConstraintChars = "~^<>=! ,|"
+
+// IsSemverConstraint returns true if the pattern is a semantic version constraint like "~1.4", "^2" or ">=1.2 <2"
+func IsSemverConstraint(pattern string) bool {
+	return strings.ContainsAny(pattern, semverConstraintChars)
+}
+
+// SemverConstraint is a parsed semantic version constraint. A version satisfies the constraint if it satisfies every
+// comparator of any one of the ranges. Pre-releases are allowed unless the constraint contains "!prerelease".
+type SemverConstraint struct {
+	ranges          [][]comparator
+	skipPrereleases bool
+}
+
+type comparator struct {
+	op      string
+	version semver.Version
+}
+
+// ParseSemverConstraint parses a semantic version constraint. Ranges are separated by "||" and the comparators of a
+// range by spaces or commas. The following comparators are supported, where versions may omit the minor and patch
+// numbers and may have a leading "v":
+// - "=1.2.3", ">1.2", ">=1.2", "<2", "<=2.1" compare against the version
+// - "1.2" matches any 1.2.x version and "1.2.3" matches exactly that version
+// - "~1.4" matches patch releases (>=1.4.0 <1.5.0), "~1" matches minor releases (>=1.0.0 <2.0.0)
+// - "^1.4" matches releases that don't change the left-most non-zero number (>=1.4.0 <2.0.0)
+// - "!prerelease" excludes versions with a pre-release suffix like "1.2.0-rc1"
+func ParseSemverConstraint(constraint string) (*SemverConstraint, error) {
+	result := &SemverConstraint{}
+	for _, rangeStr := range strings.Split(constraint, "||") {
+		var comparators []comparator
+		for _, part := range strings.FieldsFunc(rangeStr, func(r rune) bool {
+			return r == ' ' || r == ','
+		}) {
+			if part == "!prerelease" {
+				result.skipPrereleases = true
+				continue
+			}
+			c, err := parseComparator(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid semantic version constraint %q: %w", constraint, err)
+			}
+			comparators = append(comparators, c...)
+		}
+		result.ranges = append(result.ranges, comparators)
+	}
+	return result, nil
+}
+
+func parseComparator(s string) ([]comparator, error) {
+	var op string
+	for _, prefix := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(s, prefix) {
+			op, s = prefix, strings.TrimPrefix(s, prefix)
+			break
+		}
+	}
+
+	version, parts, err := parsePartialVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case ">=", ">", "<=", "=":
+		if op == ">" && parts < 3 {
+			// ">1.2" means greater than every 1.2.x version
+			return []comparator{{op: ">=", version: bump(version, parts-1)}}, nil
+		} else if op == "<=" && parts < 3 {
+			return []comparator{{op: "<", version: bump(version, parts-1)}}, nil
+		} else if op == "=" && parts < 3 {
+			return []comparator{{op: ">=", version: version}, {op: "<", version: bump(version, parts-1)}}, nil
+		}
+		return []comparator{{op: op, version: version}}, nil
+	case "<":
+		if parts < 3 {
+			// "<2" means less than every 2.x version, including its pre-releases
+			return []comparator{{op: "<", version: lowest(version)}}, nil
+		}
+		return []comparator{{op: "<", version: version}}, nil
+	case "~":
+		index := 1
+		if parts == 1 {
+			index = 0
+		}
+		return []comparator{{op: ">=", version: version}, {op: "<", version: bump(version, index)}}, nil
+	case "^":
+		index := 0
+		if version.Major == 0 && parts > 1 {
+			index = 1
+			if version.Minor == 0 && parts > 2 {
+				index = 2
+			}
+		}
+		return []comparator{{op: ">=", version: version}, {op: "<", version: bump(version, index)}}, nil
+	default:
+		if parts == 3 {
+			return []comparator{{op: "=", version: version}}, nil
+		}
+		return []comparator{{op: ">=", version: version}, {op: "<", version: bump(version, parts-1)}}, nil
+	}
+}
+
+// parsePartialVersion parses a version that may omit the minor and patch numbers and returns how many of the major,
+// minor and patch numbers were given
+func parsePartialVersion(s string) (semver.Version, int, error) {
+	s = strings.TrimPrefix(s, "v")
+	core := s
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+	parts := strings.Count(core, ".") + 1
+	if core == "" || parts > 3 {
+		return semver.Version{}, 0, fmt.Errorf("invalid version %q", s)
+	}
+	version, err := semver.ParseTolerant(s)
+	if err != nil {
+		return semver.Version{}, 0, fmt.Errorf("invalid version %q: %w", s, err)
+	}
+	return version, parts, nil
+}
+
+// bump returns the lowest version (including pre-releases) after every version that matches the first index+1 numbers
+// of version. For example, bumping 1.4.2 at index 1 returns 1.5.0-0.
+func bump(version semver.Version, index int) semver.Version {
+	result := semver.Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch}
+	switch index {
+	case 0:
+		result.Major, result.Minor, result.Patch = result.Major+1, 0, 0
+	case 1:
+		result.Minor, result.Patch = result.Minor+1, 0
+	default:
+		result.Patch++
+	}
+	return lowest(result)
+}
+
+// lowest returns the lowest version with the same major, minor and patch numbers, so that an upper bound of a range
+// also excludes the pre-releases of that version
+func lowest(version semver.Version) semver.Version {
+	if len(version.Pre) > 0 {
+		return version
+	}
+	version.Pre = []semver.PRVersion{{VersionNum: 0, IsNum: true}}
+	return version
+}
+
+// Matches returns true if the version satisfies the constraint
+func (c *SemverConstraint) Matches(version semver.Version) bool {
+	if c.skipPrereleases && len(version.Pre) > 0 {
+		return false
+	}
+	for _, comparators := range c.ranges {
+		if matchesAll(comparators, version) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAll(comparators []comparator, version semver.Version) bool {
+	for _, c := range comparators {
+		cmp := version.Compare(c.version)
+		switch c.op {
+		case "=":
+			if cmp != 0 {
+				return false
+			}
+		case ">":
+			if cmp <= 0 {
+				return false
+			}
+		case ">=":
+			if cmp < 0 {
+				return false
+			}
+		case "<":
+			if cmp >= 0 {
+				return false
+			}
+		case "<=":
+			if cmp > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// FindLatestSemver returns the tag with the highest semantic version that satisfies the constraint, or current if no
+// tag satisfies it. Tags that aren't semantic versions are ignored, and a leading "v" is allowed.
+func FindLatestSemver(current, constraint string, tags []string) (string, error) {
+	c, err := ParseSemverConstraint(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest        = current
+		latestVersion *semver.Version
+	)
+	if v, err := semver.Parse(strings.TrimPrefix(current, "v")); err == nil && c.Matches(v) {
+		latestVersion = &v
+	}
+
+	for _, tag := range tags {
+		v, err := semver.Parse(strings.TrimPrefix(tag, "v"))
+		if err != nil || !c.Matches(v) {
+			continue
+		}
+		if latestVersion == nil || v.GT(*latestVersion) {
+			latest, latestVersion = tag, &v
+		}
+	}
+
+	return latest, nil
+}
@@ -63,7 +63,14 @@ func FindLatestTagForImageWithPattern(ctx context.Context, c kclient.Client, nam
 // - "v#.#" - Matches: "v1.0", "v2.0" (return as latest). Doesn't match: "v1.alpha", "1.0", "v1.0.0"
 // - "v1.0-*" - Matches: "v1.0-alpha", "v1.0-beta" (returned as latest). Doesn't match: "v1.0"
 // - "v1.#-**" - Matches: "v1.0-cv23jkha", "v1.1-2020-01-01" (returned as latest).
+//
+// Alternatively, the pattern can be a semantic version constraint like "~1.4", "^2", ">=1.2 <2" or "^1 !prerelease", in
+// which case the tag with the highest version satisfying the constraint is returned. See ParseSemverConstraint.
 func FindLatest(current, pattern string, tags []string) (string, error) {
+	if IsSemverConstraint(pattern) {
+		return FindLatestSemver(current, pattern, tags)
+	}
+
 	pattern = "^" + pattern + "$"
 
 	// ** denotes a part of the tag that should be completely ignored for both matching and sorting. Replace it with
@@ -35,6 +35,65 @@ func TestTags(t *testing.T) {
 	test(t, "*", 2, []string{"v1.0-alpha.100", "v1.0-beta", "v1.0-zeta"})
 }
 
+func TestSemverTags(t *testing.T) {
+	tags := []string{"v1.3.9", "1.4.0", "1.4.7", "v1.5.0-rc.1", "1.5.0", "1.10.2", "2.0.0-beta.1", "2.0.0", "2.3.1", "latest", "1.4"}
+
+	// Tilde allows patch releases when the minor version is given, and minor releases when it isn't
+	test(t, "~1.4", 2, tags)
+	test(t, "~1", 5, tags)
+	test(t, "~1.4.1", 2, tags)
+
+	// Caret allows everything that doesn't change the major version
+	test(t, "^1.4", 5, tags)
+	test(t, "^2", 8, tags)
+
+	// Comparators, ranges and unions
+	test(t, ">=1.2 <2", 5, tags)
+	test(t, ">=1.2, <1.5", 2, tags)
+	test(t, "<=1.5", 4, tags)
+	test(t, ">1.4 <1.5 || ~1.3", 0, tags)
+	test(t, "=1.4", 2, tags)
+
+	// A partial upper bound excludes the pre-releases of that version, a full one doesn't
+	test(t, "<2", 5, tags)
+	test(t, ">=1.4 <1.5", 2, tags)
+
+	// Pre-releases are allowed unless excluded
+	test(t, ">=1.4 <1.5.0", 3, tags)
+	test(t, ">=2.0.0-0 <2.0.0", 6, tags)
+	latest, err := FindLatest("latest", ">=2.0.0-0 <2.0.0 !prerelease", tags)
+	assert.NoError(t, err)
+	assert.Equal(t, "latest", latest)
+
+	// Nothing matches, so current is returned
+	latest, err = FindLatest("latest", "^3", tags)
+	assert.NoError(t, err)
+	assert.Equal(t, "latest", latest)
+
+	_, err = FindLatest("latest", ">=1.x", tags)
+	assert.Error(t, err)
+}
+
+func TestAutoUpgradePattern(t *testing.T) {
+	for image, pattern := range map[string]string{
+		"ghcr.io/acorn-io/app:v#.#":             "v#.#",
+		"ghcr.io/acorn-io/app:~1.4":             "~1.4",
+		"ghcr.io/acorn-io/app:>=1.2 <2":         ">=1.2 <2",
+		"localhost:5000/app:^2 !prerelease":     "^2 !prerelease",
+		"localhost:5000/app:1.4":                "",
+		"localhost:5000/app":                    "",
+		"ghcr.io/acorn-io/app:latest":           "",
+		"ghcr.io/acorn-io/app:1.4.0 || ~2.0":    "1.4.0 || ~2.0",
+		"ghcr.io/acorn-io/app:notasemver-range": "",
+	} {
+		p, isPattern := AutoUpgradePattern(image)
+		assert.Equal(t, pattern != "", isPattern, image)
+		if isPattern {
+			assert.Equal(t, pattern, p, image)
+		}
+	}
+}
+
 func test(t *testing.T, pattern string, expectedIndex int, tags []string) {
 	t.Helper()
 	latest, err := FindLatest("", pattern, tags)
@@ -0,0 +1,53 @@
+package autoupgrade
+
+import (
+	"sync"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/baaah/pkg/router"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	requestedChecksLock sync.Mutex
+	requestedChecks     = map[kclient.ObjectKey]string{}
+)
+
+// CheckRequested returns the time at which an immediate check of the app was requested, for example by a registry
+// push notification
+func CheckRequested(app v1.AppInstance) (time.Time, bool) {
+	value := app.Annotations[labels.AcornUpgradeCheckRequested]
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// RequestCheck triggers a sync if an immediate check of the app was requested since the last time this function saw
+// the app. The daemon will then check the app regardless of its auto-upgrade interval.
+func RequestCheck(app *v1.AppInstance) {
+	key := router.Key(app.Namespace, app.Name)
+	value := app.Annotations[labels.AcornUpgradeCheckRequested]
+
+	requestedChecksLock.Lock()
+	defer requestedChecksLock.Unlock()
+
+	if value == "" || !app.DeletionTimestamp.IsZero() {
+		delete(requestedChecks, key)
+		return
+	}
+	if requestedChecks[key] == value {
+		return
+	}
+	requestedChecks[key] = value
+
+	if _, ok := Mode(app.Spec); ok {
+		Sync()
+	}
+}
@@ -0,0 +1,194 @@
+package autoupgrade
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxUpgradeHistory is the number of upgrade decisions kept in an app's status
+const maxUpgradeHistory = 10
+
+var dayNames = map[string]int{
+	"sun": 0,
+	"mon": 1,
+	"tue": 2,
+	"wed": 3,
+	"thu": 4,
+	"fri": 5,
+	"sat": 6,
+}
+
+// ValidateUpgradePolicy returns an error if the windows or soak period of the policy are invalid
+func ValidateUpgradePolicy(policy *v1.UpgradePolicy) error {
+	if policy == nil {
+		return nil
+	}
+	for _, window := range policy.Windows {
+		if _, err := parseWindow(window); err != nil {
+			return err
+		}
+	}
+	if _, err := SoakPeriod(policy); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SoakPeriod returns the parsed soak period of the policy, zero means there is no soak period
+func SoakPeriod(policy *v1.UpgradePolicy) (time.Duration, error) {
+	if policy == nil || policy.SoakPeriod == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(policy.SoakPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid upgrade soak period %s: %w", policy.SoakPeriod, err)
+	} else if d < 0 {
+		return 0, fmt.Errorf("invalid upgrade soak period %s: must not be negative", policy.SoakPeriod)
+	}
+	return d, nil
+}
+
+// InWindow returns true if an upgrade may be applied at the given time. Upgrades may be applied at any time if the
+// policy has no windows.
+func InWindow(policy *v1.UpgradePolicy, now time.Time) (bool, error) {
+	if policy == nil || len(policy.Windows) == 0 {
+		return true, nil
+	}
+	for _, window := range policy.Windows {
+		w, err := parseWindow(window)
+		if err != nil {
+			return false, err
+		}
+		local := now.In(w.location)
+		if w.days[int(local.Weekday())] && w.hours[local.Hour()] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type window struct {
+	days     map[int]bool
+	hours    map[int]bool
+	location *time.Location
+}
+
+func parseWindow(w v1.UpgradeWindow) (window, error) {
+	var (
+		result window
+		err    error
+	)
+	result.days, err = parseCronField(w.Days, 0, 7, dayNames)
+	if err != nil {
+		return result, fmt.Errorf("invalid days %q in upgrade window %s: %w", w.Days, w, err)
+	}
+	// Both 0 and 7 are Sunday in cron
+	if result.days[7] {
+		result.days[0] = true
+	}
+	result.hours, err = parseCronField(w.Hours, 0, 23, nil)
+	if err != nil {
+		return result, fmt.Errorf("invalid hours %q in upgrade window %s: %w", w.Hours, w, err)
+	}
+	result.location = time.UTC
+	if w.TimeZone != "" {
+		result.location, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return result, fmt.Errorf("invalid time zone in upgrade window %s: %w", w, err)
+		}
+	}
+	return result, nil
+}
+
+// parseCronField parses a cron field made up of comma separated values, ranges ("1-5") and steps ("*/2", "8-18/2")
+func parseCronField(field string, min, max int, names map[string]int) (map[int]bool, error) {
+	if field == "" {
+		return nil, fmt.Errorf("must not be empty")
+	}
+
+	result := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if rangeStr, stepStr, ok := strings.Cut(part, "/"); ok {
+			s, err := strconv.Atoi(stepStr)
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepStr)
+			}
+			part, step = rangeStr, s
+		}
+
+		start, end := min, max
+		if part != "*" {
+			startStr, endStr, isRange := strings.Cut(part, "-")
+			var err error
+			if start, err = parseCronValue(startStr, min, max, names); err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronValue(endStr, min, max, names); err != nil {
+					return nil, err
+				} else if end < start {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+			} else if step > 1 {
+				end = max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			result[i] = true
+		}
+	}
+	return result, nil
+}
+
+func parseCronValue(s string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < min || v > max {
+		return 0, fmt.Errorf("invalid value %q, must be between %d and %d", s, min, max)
+	}
+	return v, nil
+}
+
+// RecordDecision adds the decision to the upgrade history of the app, keeping only the most recent decisions. A
+// deferred decision for the same image as the latest decision is not recorded again.
+func RecordDecision(status *v1.AppInstanceStatus, decision, image, digest, message string, now time.Time) {
+	if decision == v1.UpgradeDecisionDeferred && len(status.UpgradeHistory) > 0 {
+		last := status.UpgradeHistory[len(status.UpgradeHistory)-1]
+		if last.Decision == decision && last.Image == image && last.Digest == digest {
+			return
+		}
+	}
+
+	status.UpgradeHistory = append(status.UpgradeHistory, v1.UpgradeDecision{
+		Time:     metav1.NewTime(now),
+		Decision: decision,
+		Image:    image,
+		Digest:   digest,
+		Message:  message,
+	})
+	if len(status.UpgradeHistory) > maxUpgradeHistory {
+		status.UpgradeHistory = status.UpgradeHistory[len(status.UpgradeHistory)-maxUpgradeHistory:]
+	}
+}
+
+// Reverted returns true if an upgrade to the image was reverted and shouldn't be attempted again. If digest is empty,
+// only the image is compared, otherwise new content pushed to a reverted tag can be tried again.
+func Reverted(status v1.AppInstanceStatus, image, digest string) bool {
+	for _, decision := range status.UpgradeHistory {
+		if decision.Decision == v1.UpgradeDecisionReverted && decision.Image == image &&
+			(digest == "" || strings.TrimPrefix(decision.Digest, "sha256:") == strings.TrimPrefix(digest, "sha256:")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package autoupgrade
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInWindow(t *testing.T) {
+	// Wednesday 2023-01-04 03:30 UTC, which is Tuesday 21:30 in Chicago
+	now := time.Date(2023, 1, 4, 3, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		windows []v1.UpgradeWindow
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "no windows",
+			want: true,
+		},
+		{
+			name:    "every day and hour",
+			windows: []v1.UpgradeWindow{{Days: "*", Hours: "*"}},
+			want:    true,
+		},
+		{
+			name:    "weekday range with names",
+			windows: []v1.UpgradeWindow{{Days: "mon-fri", Hours: "2-4"}},
+			want:    true,
+		},
+		{
+			name:    "outside hours",
+			windows: []v1.UpgradeWindow{{Days: "*", Hours: "22,23"}},
+		},
+		{
+			name:    "weekend only",
+			windows: []v1.UpgradeWindow{{Days: "sat,sun", Hours: "*"}},
+		},
+		{
+			name:    "hour steps",
+			windows: []v1.UpgradeWindow{{Days: "3", Hours: "*/3"}},
+			want:    true,
+		},
+		{
+			name:    "time zone",
+			windows: []v1.UpgradeWindow{{Days: "tue", Hours: "21", TimeZone: "America/Chicago"}},
+			want:    true,
+		},
+		{
+			name: "second window matches",
+			windows: []v1.UpgradeWindow{
+				{Days: "0,6", Hours: "*"},
+				{Days: "1-5", Hours: "3"},
+			},
+			want: true,
+		},
+		{
+			name:    "invalid hour",
+			windows: []v1.UpgradeWindow{{Days: "*", Hours: "24"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid day",
+			windows: []v1.UpgradeWindow{{Days: "someday", Hours: "*"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			windows: []v1.UpgradeWindow{{Days: "*", Hours: "*", TimeZone: "Mars/Olympus"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InWindow(&v1.UpgradePolicy{Windows: tt.windows}, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCronFieldSunday(t *testing.T) {
+	w, err := parseWindow(v1.UpgradeWindow{Days: "7", Hours: "0"})
+	require.NoError(t, err)
+	assert.True(t, w.days[0])
+}
+
+func TestRecordDecision(t *testing.T) {
+	now := time.Now()
+	status := &v1.AppInstanceStatus{}
+
+	RecordDecision(status, v1.UpgradeDecisionDeferred, "acorn/app:v2", "", "outside of upgrade windows", now)
+	RecordDecision(status, v1.UpgradeDecisionDeferred, "acorn/app:v2", "", "outside of upgrade windows", now)
+	assert.Len(t, status.UpgradeHistory, 1)
+
+	RecordDecision(status, v1.UpgradeDecisionApplied, "acorn/app:v2", "sha256:abcd", "", now)
+	RecordDecision(status, v1.UpgradeDecisionReverted, "acorn/app:v2", "sha256:abcd", "app did not become ready", now)
+	assert.Len(t, status.UpgradeHistory, 3)
+	assert.True(t, Reverted(*status, "acorn/app:v2", ""))
+	assert.True(t, Reverted(*status, "acorn/app:v2", "abcd"))
+	assert.False(t, Reverted(*status, "acorn/app:v2", "sha256:ef01"))
+	assert.False(t, Reverted(*status, "acorn/app:v3", ""))
+
+	for i := 0; i < 20; i++ {
+		RecordDecision(status, v1.UpgradeDecisionApplied, "acorn/app:v3", "", "", now)
+	}
+	assert.Len(t, status.UpgradeHistory, maxUpgradeHistory)
+}
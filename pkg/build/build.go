@@ -65,7 +65,9 @@ func Build(ctx context.Context, messages buildclient.Messages, pushRepo string,
 	if err != nil {
 		return nil, err
 	}
-	buildSpec.Platforms = opts.Platforms
+	if len(opts.Platforms) > 0 {
+		buildSpec.Platforms = opts.Platforms
+	}
 
 	imageData, err := FromSpec(ctx, pushRepo, *buildSpec, messages, keychain, remoteOpts)
 	appImage := &v1.AppImage{
@@ -73,11 +75,16 @@ func Build(ctx context.Context, messages buildclient.Messages, pushRepo string,
 		ImageData: imageData,
 		BuildArgs: buildArgs,
 		VCS:       opts.VCS,
+		Platforms: imageData.Platforms(),
 	}
 	if err != nil {
 		return nil, err
 	}
 
+	if err := ValidatePlatforms(*buildSpec, imageData); err != nil {
+		return nil, err
+	}
+
 	id, err := FromAppImage(ctx, pushRepo, appImage, messages, &AppImageOptions{
 		Keychain:      keychain,
 		RemoteOptions: remoteOpts,
@@ -108,14 +115,15 @@ func buildContainers(ctx context.Context, pushRepo string, buildCache *buildCach
 			}
 		}
 
-		id, err := fromBuild(ctx, pushRepo, buildCache, platforms, *container.Build, messages, keychain, opts)
+		id, builtPlatforms, err := fromBuild(ctx, pushRepo, buildCache, platforms, *container.Build, messages, keychain, opts)
 		if err != nil {
 			return nil, err
 		}
 
 		result[key] = v1.ContainerData{
-			Image:    id,
-			Sidecars: map[string]v1.ImageData{},
+			Image:     id,
+			Platforms: builtPlatforms,
+			Sidecars:  map[string]v1.ImageData{},
 		}
 
 		var sidecarKeys []string
@@ -137,12 +145,13 @@ func buildContainers(ctx context.Context, pushRepo string, buildCache *buildCach
 				}
 			}
 
-			id, err := fromBuild(ctx, pushRepo, buildCache, platforms, *sidecar.Build, messages, keychain, opts)
+			id, builtPlatforms, err := fromBuild(ctx, pushRepo, buildCache, platforms, *sidecar.Build, messages, keychain, opts)
 			if err != nil {
 				return nil, err
 			}
 			result[key].Sidecars[sidecarKey] = v1.ImageData{
-				Image: id,
+				Image:     id,
+				Platforms: builtPlatforms,
 			}
 		}
 	}
@@ -161,13 +170,14 @@ func buildImages(ctx context.Context, pushRepo string, buildCache *buildCache, p
 			}
 		}
 
-		id, err := fromBuild(ctx, pushRepo, buildCache, platforms, *image.Build, messages, keychain, opts)
+		id, builtPlatforms, err := fromBuild(ctx, pushRepo, buildCache, platforms, *image.Build, messages, keychain, opts)
 		if err != nil {
 			return nil, err
 		}
 
 		result[key] = v1.ImageData{
-			Image: id,
+			Image:     id,
+			Platforms: builtPlatforms,
 		}
 	}
 
@@ -202,15 +212,19 @@ func FromSpec(ctx context.Context, pushRepo string, spec v1.BuilderSpec, message
 	return data, nil
 }
 
-func fromBuild(ctx context.Context, pushRepo string, buildCache *buildCache, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (id string, err error) {
-	id, err = buildCache.Get(build, platforms)
-	if err != nil || id != "" {
-		return id, err
+func fromBuild(ctx context.Context, pushRepo string, buildCache *buildCache, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (id string, builtPlatforms []v1.Platform, err error) {
+	if len(build.Platforms) > 0 {
+		platforms = build.Platforms
+	}
+
+	id, builtPlatforms = buildCache.Get(build, platforms)
+	if id != "" {
+		return id, builtPlatforms, nil
 	}
 
 	defer func() {
 		if err == nil && id != "" {
-			buildCache.Store(build, platforms, id)
+			buildCache.Store(build, platforms, id, builtPlatforms)
 		}
 	}()
 
@@ -237,28 +251,29 @@ func buildImageNoManifest(ctx context.Context, pushRepo string, cwd string, buil
 	return ids[0], nil
 }
 
-func buildImageAndManifest(ctx context.Context, pushRepo string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (string, error) {
+func buildImageAndManifest(ctx context.Context, pushRepo string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (string, []v1.Platform, error) {
 	platforms, ids, err := buildkit.Build(ctx, pushRepo, "", platforms, build, messages, keychain)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	if len(ids) == 1 {
-		return ids[0], nil
+		return ids[0], platforms, nil
 	}
 
-	return createManifest(ids, platforms, opts)
+	id, err := createManifest(ids, platforms, opts)
+	return id, platforms, err
 }
 
-func buildWithContext(ctx context.Context, pushRepo string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (string, error) {
+func buildWithContext(ctx context.Context, pushRepo string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, opts []remote.Option) (string, []v1.Platform, error) {
 	var (
 		baseImage = build.BaseImage
 	)
 
 	if baseImage == "" {
-		newImage, err := buildImageAndManifest(ctx, pushRepo, platforms, build.BaseBuild(), messages, keychain, opts)
+		newImage, _, err := buildImageAndManifest(ctx, pushRepo, platforms, build.BaseBuild(), messages, keychain, opts)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		baseImage = newImage
 	}
@@ -287,7 +302,12 @@ func toContextCopyDockerFile(baseImage string, contextDirs map[string]string) st
 }
 
 type buildCache struct {
-	cache map[string]string
+	cache map[string]cachedBuild
+}
+
+type cachedBuild struct {
+	id        string
+	platforms []v1.Platform
 }
 
 func (b *buildCache) toKey(platforms []v1.Platform, build v1.Build) (string, error) {
@@ -298,23 +318,27 @@ func (b *buildCache) toKey(platforms []v1.Platform, build v1.Build) (string, err
 	return string(data), err
 }
 
-func (b *buildCache) Get(build v1.Build, platforms []v1.Platform) (string, error) {
+func (b *buildCache) Get(build v1.Build, platforms []v1.Platform) (string, []v1.Platform) {
 	key, err := b.toKey(platforms, build)
 	if err != nil {
 		// ignore error and return as cache miss
 		return "", nil
 	}
-	return b.cache[key], nil
+	cached := b.cache[key]
+	return cached.id, cached.platforms
 }
 
-func (b *buildCache) Store(build v1.Build, platforms []v1.Platform, id string) {
+func (b *buildCache) Store(build v1.Build, platforms []v1.Platform, id string, builtPlatforms []v1.Platform) {
 	key, err := b.toKey(platforms, build)
 	if err != nil {
 		// ignore error and return as cache miss
 		return
 	}
 	if b.cache == nil {
-		b.cache = map[string]string{}
+		b.cache = map[string]cachedBuild{}
+	}
+	b.cache[key] = cachedBuild{
+		id:        id,
+		platforms: builtPlatforms,
 	}
-	b.cache[key] = id
 }
@@ -0,0 +1,55 @@
+package build
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/baaah/pkg/typed"
+)
+
+// ValidatePlatforms ensures that every image in data was built for all the platforms required by spec. The platforms
+// of a build override the platforms of the spec.
+func ValidatePlatforms(spec v1.BuilderSpec, data v1.ImagesData) error {
+	for _, kind := range []struct {
+		name       string
+		specs      map[string]v1.ContainerImageBuilderSpec
+		containers map[string]v1.ContainerData
+	}{
+		{name: "container", specs: spec.Containers, containers: data.Containers},
+		{name: "job", specs: spec.Jobs, containers: data.Jobs},
+	} {
+		for _, entry := range typed.Sorted(kind.specs) {
+			key, containerSpec := entry.Key, entry.Value
+			container := kind.containers[key]
+			if err := checkPlatforms(kind.name, key, spec.Platforms, containerSpec.Build, container.Platforms); err != nil {
+				return err
+			}
+			for _, sidecarEntry := range typed.Sorted(containerSpec.Sidecars) {
+				sidecarKey, sidecarSpec := sidecarEntry.Key, sidecarEntry.Value
+				if err := checkPlatforms("sidecar", key+"."+sidecarKey, spec.Platforms, sidecarSpec.Build, container.Sidecars[sidecarKey].Platforms); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, entry := range typed.Sorted(spec.Images) {
+		if err := checkPlatforms("image", entry.Key, spec.Platforms, entry.Value.Build, data.Images[entry.Key].Platforms); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkPlatforms(kind, name string, required []v1.Platform, build *v1.Build, built []v1.Platform) error {
+	if build != nil && len(build.Platforms) > 0 {
+		required = build.Platforms
+	}
+	for _, platform := range required {
+		if !v1.HasPlatform(built, platform) {
+			return fmt.Errorf("%s %s does not support required platform %s", kind, name, platform)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package build
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePlatforms(t *testing.T) {
+	var (
+		amd64 = v1.Platform{OS: "linux", Architecture: "amd64"}
+		arm64 = v1.Platform{OS: "linux", Architecture: "arm64"}
+	)
+
+	spec := v1.BuilderSpec{
+		Platforms: []v1.Platform{amd64, arm64},
+		Containers: map[string]v1.ContainerImageBuilderSpec{
+			"web": {
+				Build: &v1.Build{Context: "."},
+				Sidecars: map[string]v1.ContainerImageBuilderSpec{
+					"proxy": {Image: "nginx"},
+				},
+			},
+		},
+		Jobs: map[string]v1.ContainerImageBuilderSpec{
+			"migrate": {Build: &v1.Build{Context: "migrate", Platforms: []v1.Platform{amd64}}},
+		},
+		Images: map[string]v1.ImageBuilderSpec{
+			"tool": {Image: "busybox"},
+		},
+	}
+
+	data := v1.ImagesData{
+		Containers: map[string]v1.ContainerData{
+			"web": {
+				Platforms: []v1.Platform{amd64, arm64},
+				Sidecars: map[string]v1.ImageData{
+					"proxy": {Platforms: []v1.Platform{amd64, arm64}},
+				},
+			},
+		},
+		Jobs: map[string]v1.ContainerData{
+			"migrate": {Platforms: []v1.Platform{amd64}},
+		},
+		Images: map[string]v1.ImageData{
+			"tool": {Platforms: []v1.Platform{amd64, arm64}},
+		},
+	}
+	assert.NoError(t, ValidatePlatforms(spec, data))
+
+	data.Containers["web"].Sidecars["proxy"] = v1.ImageData{Platforms: []v1.Platform{amd64}}
+	assert.EqualError(t, ValidatePlatforms(spec, data), "sidecar web.proxy does not support required platform linux/arm64")
+
+	data.Containers["web"].Sidecars["proxy"] = v1.ImageData{Platforms: []v1.Platform{amd64, arm64}}
+	data.Images["tool"] = v1.ImageData{}
+	assert.EqualError(t, ValidatePlatforms(spec, data), "image tool does not support required platform linux/amd64")
+
+	spec.Platforms = nil
+	assert.NoError(t, ValidatePlatforms(spec, data))
+}
@@ -117,6 +117,13 @@ func retryOnConflict(f func() error) error {
 }
 
 func (s *Server) build(ctx context.Context, messages buildclient.Messages, token *Token) (*v1.AppImage, error) {
+	if imagesystem.IsClusterInternalRegistryAddressReference(token.PushRepo) {
+		if err := imagesystem.CheckRegistryWritable(ctx, s.client); err != nil {
+			_ = s.recordBuildError(ctx, &token.Build, err)
+			return nil, err
+		}
+	}
+
 	if err := retryOnConflict(func() error {
 		return s.recordBuildStart(ctx, &token.Build)
 	}); err != nil {
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewAudit(c CommandContext) *cobra.Command {
+	return cli.Command(&Audit{client: c.ClientFactory}, cobra.Command{
+		Use: "audit [flags]",
+		Example: `
+# List the recent actions of users in the current project
+acorn audit
+
+# List the actions of a user on an app in the last hour
+acorn audit --user alice@example.com --app my-app --since 1h
+
+# List the secrets that were revealed in all projects
+acorn -A audit --resource secrets/reveal`,
+		SilenceUsage: true,
+		Short:        "List the recent actions of users recorded in the audit log",
+		Long: `List the recent actions of users recorded in the audit log. Only the most recent entries are kept by the
+API server, configure an audit log file or webhook to keep the full audit log.`,
+		Args: cobra.NoArgs,
+	})
+}
+
+type Audit struct {
+	User     string `usage:"Only show the actions of the user"`
+	App      string `usage:"Only show the actions on the app"`
+	Resource string `usage:"Only show the actions on the resource, e.g. apps or secrets/reveal"`
+	Since    string `short:"s" usage:"Only show the actions since a duration (e.g. 42m for 42 minutes)"`
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client   ClientFactory
+}
+
+func (a *Audit) Run(cmd *cobra.Command, args []string) error {
+	var since time.Time
+	if a.Since != "" {
+		d, err := time.ParseDuration(a.Since)
+		if err != nil {
+			return fmt.Errorf("invalid --since %q: %w", a.Since, err)
+		}
+		since = time.Now().Add(-d)
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.AuditEntryList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.AuditEntry, false, a.Output)
+	for _, entry := range entries {
+		resource := entry.Resource
+		if entry.Subresource != "" {
+			resource += "/" + entry.Subresource
+		}
+		if (a.User != "" && entry.User != a.User) ||
+			(a.App != "" && entry.App != a.App) ||
+			(a.Resource != "" && entry.Resource != a.Resource && resource != a.Resource) ||
+			(!since.IsZero() && entry.CreationTimestamp.Before(&metav1.Time{Time: since})) {
+			continue
+		}
+		out.Write(entry)
+	}
+	return out.Err()
+}
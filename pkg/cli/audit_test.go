@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAudit(t *testing.T) {
+	entries := []apiv1.AuditEntry{
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "1", Namespace: "acorn"},
+			User:         "alice@example.com",
+			Verb:         "create",
+			APIGroup:     "api.acorn.io",
+			Resource:     "apps",
+			ResourceName: "my-app",
+			Project:      "acorn",
+			App:          "my-app",
+			StatusCode:   201,
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "2", Namespace: "acorn"},
+			User:         "bob@example.com",
+			Verb:         "get",
+			APIGroup:     "api.acorn.io",
+			Resource:     "secrets",
+			Subresource:  "reveal",
+			ResourceName: "my-app.db-password",
+			Project:      "acorn",
+			App:          "my-app",
+			StatusCode:   200,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn audit",
+			args:    []string{},
+			wantOut: "TIME       USER                VERB      RESOURCE         NAME                 PROJECT   APP       CODE\n292y ago   alice@example.com   create    apps             my-app               acorn     my-app    201\n292y ago   bob@example.com     get       secrets/reveal   my-app.db-password   acorn     my-app    200\n",
+		},
+		{
+			name:    "acorn audit --user",
+			args:    []string{"--user", "bob@example.com"},
+			wantOut: "TIME       USER              VERB      RESOURCE         NAME                 PROJECT   APP       CODE\n292y ago   bob@example.com   get       secrets/reveal   my-app.db-password   acorn     my-app    200\n",
+		},
+		{
+			name:    "acorn audit --resource",
+			args:    []string{"--resource", "apps"},
+			wantOut: "TIME       USER                VERB      RESOURCE   NAME      PROJECT   APP       CODE\n292y ago   alice@example.com   create    apps       my-app    acorn     my-app    201\n",
+		},
+		{
+			name:    "acorn audit --since",
+			args:    []string{"--since", "1h"},
+			wantOut: "TIME      USER      VERB      RESOURCE   NAME      PROJECT   APP       CODE\n",
+		},
+		{
+			name:    "acorn audit invalid --since",
+			args:    []string{"--since", "yesterday"},
+			wantErr: `invalid --since "yesterday": time: invalid duration "yesterday"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewAudit(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{AuditEntryList: entries},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			if tt.wantErr == "" {
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
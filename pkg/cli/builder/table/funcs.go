@@ -8,6 +8,7 @@ import (
 	"time"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	adminv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/tags"
 	"github.com/rancher/wrangler/pkg/data/convert"
@@ -38,6 +39,7 @@ var (
 		"displayRange":  DisplayRange,
 		"memoryToRange": MemoryToRange,
 		"defaultMemory": DefaultMemory,
+		"lastDelivery":  LastDelivery,
 	}
 )
 
@@ -242,6 +244,18 @@ func MemoryToRange(obj any) (string, error) {
 	return fmt.Sprintf("%v-%v", min, max), nil
 }
 
+// LastDelivery describes the outcome of the most recent webhook delivery
+func LastDelivery(deliveries []v1.UpgradeWebhookDelivery) string {
+	if len(deliveries) == 0 {
+		return ""
+	}
+	last := deliveries[len(deliveries)-1]
+	if last.Error != "" {
+		return "failed " + FormatCreated(last.Time)
+	}
+	return fmt.Sprintf("%d %s", last.StatusCode, FormatCreated(last.Time))
+}
+
 func AppGeneration(app apiv1.App, msg string) string {
 	if app.Generation != app.Status.ObservedGeneration {
 		return "[controller: not processed] " + msg
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewImagePolicies(c CommandContext) *cobra.Command {
+	return cli.Command(&ImagePolicy{client: c.ClientFactory}, cobra.Command{
+		Use:     "imagepolicies [flags] [IMAGEPOLICY_NAME...]",
+		Aliases: []string{"imagepolicy", "ip"},
+		Example: `
+acorn offerings imagepolicies`,
+		SilenceUsage: true,
+		Short:        "List the image policies that apply to the current project",
+	})
+}
+
+type ImagePolicy struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *ImagePolicy) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ActiveImagePolicy, a.Quiet, a.Output)
+
+	policies, err := c.ImagePolicyList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if len(args) == 0 || slices.Contains(args, policy.Name) {
+			out.Write(policy)
+		}
+	}
+
+	return out.Err()
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestImagePolicies(t *testing.T) {
+	policies := []apiv1.ImagePolicy{
+		{
+			ObjectMeta:             metav1.ObjectMeta{Name: "team"},
+			Scope:                  apiv1.ImagePolicyScopeProject,
+			AllowedImages:          []string{"ghcr.io/acorn-io/**"},
+			VulnerabilityThreshold: "critical",
+			Description:            "Team images",
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "no-dev"},
+			Scope:        apiv1.ImagePolicyScopeCluster,
+			DeniedImages: []string{"**:*-dev"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name:    "acorn offerings imagepolicies",
+			args:    []string{},
+			wantOut: "NAME      SCOPE     ALLOWED-IMAGES          DENIED-IMAGES   PUBLIC-KEYS   VULNERABILITY-THRESHOLD   DESCRIPTION\nteam      project   [ghcr.io/acorn-io/**]                   0             critical                  Team images\nno-dev    cluster                           [**:*-dev]      0                                       \n",
+		},
+		{
+			name:    "acorn offerings imagepolicies with arg",
+			args:    []string{"no-dev"},
+			wantOut: "NAME      SCOPE     ALLOWED-IMAGES   DENIED-IMAGES   PUBLIC-KEYS   VULNERABILITY-THRESHOLD   DESCRIPTION\nno-dev    cluster                    [**:*-dev]      0                                       \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImagePolicies(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{ImagePolicyList: policies},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.NoError(t, cmd.Execute())
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
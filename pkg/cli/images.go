@@ -26,6 +26,10 @@ acorn images`,
 		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
 	})
 	cmd.AddCommand(NewImageDelete(c))
+	cmd.AddCommand(NewImageSign(c))
+	cmd.AddCommand(NewImageScan(c))
+	cmd.AddCommand(NewImageDetails(c))
+	cmd.AddCommand(NewImagePrune(c))
 	return cmd
 }
 
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/spf13/cobra"
+)
+
+func NewImageDetails(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageDetails{client: c.ClientFactory}, cobra.Command{
+		Use: "details [flags] IMAGE",
+		Example: `# Show the images and platforms of an app image
+acorn image details my-image:v1`,
+		SilenceUsage:      true,
+		Short:             "Show the details of an Image",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type ImageDetails struct {
+	client  ClientFactory
+	Output  string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	NoTrunc bool   `usage:"Don't truncate IDs"`
+}
+
+type imageDetailsPrint struct {
+	Name      string        `json:"name,omitempty"`
+	Type      string        `json:"type,omitempty"`
+	Image     string        `json:"image,omitempty"`
+	Platforms []v1.Platform `json:"platforms,omitempty"`
+}
+
+func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	details, err := c.ImageDetails(cmd.Context(), args[0], nil)
+	if err != nil {
+		return err
+	}
+
+	if a.Output != "" {
+		out := table.NewWriter(tables.ImageDetails, false, a.Output)
+		out.Write(details)
+		return out.Err()
+	}
+
+	out := table.NewWriter(tables.ImageDetails, false, a.Output)
+	out.AddFormatFunc("trunc", func(str string) string {
+		if a.NoTrunc || len(str) < 12 {
+			return str
+		}
+		return strings.TrimPrefix(str, "sha256:")[:12]
+	})
+	out.AddFormatFunc("platforms", formatPlatforms)
+
+	for _, containers := range []struct {
+		kind string
+		data map[string]v1.ContainerData
+	}{
+		{kind: "container", data: details.AppImage.ImageData.Containers},
+		{kind: "job", data: details.AppImage.ImageData.Jobs},
+	} {
+		for _, entry := range typed.Sorted(containers.data) {
+			out.Write(imageDetailsPrint{
+				Name:      entry.Key,
+				Type:      containers.kind,
+				Image:     entry.Value.Image,
+				Platforms: entry.Value.Platforms,
+			})
+			for _, sidecar := range typed.Sorted(entry.Value.Sidecars) {
+				out.Write(imageDetailsPrint{
+					Name:      entry.Key + "." + sidecar.Key,
+					Type:      "sidecar",
+					Image:     sidecar.Value.Image,
+					Platforms: sidecar.Value.Platforms,
+				})
+			}
+		}
+	}
+	for _, entry := range typed.Sorted(details.AppImage.ImageData.Images) {
+		out.Write(imageDetailsPrint{
+			Name:      entry.Key,
+			Type:      "image",
+			Image:     entry.Value.Image,
+			Platforms: entry.Value.Platforms,
+		})
+	}
+
+	if err := out.Err(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nPlatforms: %s\n", formatPlatforms(details.AppImage.Platforms))
+	return nil
+}
+
+func formatPlatforms(platforms []v1.Platform) string {
+	if len(platforms) == 0 {
+		return "unknown"
+	}
+	result := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		result = append(result, platform.String())
+	}
+	return strings.Join(result, ", ")
+}
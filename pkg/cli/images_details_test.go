@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageDetails(t *testing.T) {
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name: "acorn image details",
+			args: []string{"found"},
+			wantOut: `NAME                           TYPE        IMAGE          PLATFORMS
+test-image-running-container   container   test-image-r   linux/amd64, linux/arm64
+
+Platforms: linux/amd64, linux/arm64
+`,
+		},
+		{
+			name: "acorn image details no trunc",
+			args: []string{"found", "--no-trunc"},
+			wantOut: `NAME                           TYPE        IMAGE                          PLATFORMS
+test-image-running-container   container   test-image-running-container   linux/amd64, linux/arm64
+
+Platforms: linux/amd64, linux/arm64
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImageDetails(commandContext)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			assert.NoError(t, err)
+			w.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewImagePrune(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImagePrune{client: c.ClientFactory}, cobra.Command{
+		Use: "prune [flags]",
+		Example: `# Show the images that would be pruned by the image retention policy
+acorn image prune --dry-run
+
+# Prune images according to the image retention policy
+acorn image prune`,
+		SilenceUsage: true,
+		Short:        "Prune Images according to the image retention policy",
+		Args:         cobra.NoArgs,
+	})
+	return cmd
+}
+
+type ImagePrune struct {
+	client ClientFactory
+	DryRun bool   `usage:"Only print the images that would be pruned"`
+	Quiet  bool   `usage:"Output only image IDs" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+}
+
+func (a *ImagePrune) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	prune, err := c.ImagePrune(cmd.Context(), &client.ImagePruneOptions{
+		DryRun: a.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ImagePrune, a.Quiet, a.Output)
+	if a.Quiet {
+		out = table.NewWriter([][]string{
+			{"Name", "{{ .Name }}"},
+		}, true, a.Output)
+	}
+
+	for _, image := range prune.Images {
+		out.Write(image)
+	}
+
+	if err := out.Err(); err != nil {
+		return err
+	}
+
+	if !a.Quiet && a.Output == "" {
+		if a.DryRun {
+			fmt.Printf("\n%d image(s) would be pruned\n", len(prune.Images))
+		} else {
+			fmt.Printf("\n%d image(s) pruned\n", len(prune.Images))
+		}
+	}
+	return nil
+}
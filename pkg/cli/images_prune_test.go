@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePrune(t *testing.T) {
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn image prune quiet",
+			args:    []string{"-q"},
+			wantOut: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef\n",
+		},
+		{
+			name:    "acorn image prune dry run",
+			args:    []string{"--dry-run", "-o", "{{ .Digest }}"},
+			wantOut: "sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef\n",
+		},
+		{
+			name:    "acorn image prune with args",
+			args:    []string{"found"},
+			wantErr: true,
+			wantOut: `unknown command "found" for "prune"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImagePrune(commandContext)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
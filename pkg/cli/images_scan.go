@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/imagescan"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewImageScan(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageScan{client: c.ClientFactory}, cobra.Command{
+		Use: "scan [flags] IMAGE",
+		Example: `# Scan an image for vulnerabilities
+acorn image scan my-image:v1
+
+# Fail if the image has any high or critical vulnerabilities
+acorn image scan --threshold high my-image:v1`,
+		SilenceUsage:      true,
+		Short:             "Scan an Image for vulnerabilities",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type ImageScan struct {
+	client    ClientFactory
+	Output    string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Threshold string `usage:"Return an error if the image has vulnerabilities at or above this severity (critical, high, medium, low)"`
+}
+
+func (a *ImageScan) Run(cmd *cobra.Command, args []string) error {
+	var threshold string
+	if a.Threshold != "" {
+		var err error
+		threshold, err = imagescan.ParseSeverity(a.Threshold)
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	scan, err := c.ImageScan(cmd.Context(), args[0])
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", args[0], err)
+	}
+
+	if a.Output != "" {
+		out := table.NewWriter(tables.Vulnerability, false, a.Output)
+		out.Write(scan)
+		if err := out.Err(); err != nil {
+			return err
+		}
+	} else {
+		out := table.NewWriter(tables.Vulnerability, false, a.Output)
+		for _, vuln := range scan.Vulnerabilities {
+			out.Write(vuln)
+		}
+		if err := out.Err(); err != nil {
+			return err
+		}
+		fmt.Printf("\n%d critical, %d high, %d medium, %d low, %d unknown\n",
+			scan.Status.Critical, scan.Status.High, scan.Status.Medium, scan.Status.Low, scan.Status.Unknown)
+	}
+
+	if threshold != "" {
+		if count := imagescan.AtOrAbove(scan.Status, threshold); count > 0 {
+			return fmt.Errorf("%s has %d vulnerabilities with %s or higher severity", args[0], count, strings.ToLower(threshold))
+		}
+	}
+	return nil
+}
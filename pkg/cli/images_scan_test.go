@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScan(t *testing.T) {
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn image scan found",
+			args: []string{"found"},
+			wantOut: `ID              SEVERITY   PACKAGE   INSTALLED   FIXED     TITLE
+CVE-2023-0001   CRITICAL   openssl   1.1.1       1.1.2     openssl bug
+CVE-2023-0002   LOW        zlib      1.2.3                 
+
+1 critical, 0 high, 0 medium, 1 low, 0 unknown
+`,
+		},
+		{
+			name:    "acorn image scan below threshold",
+			args:    []string{"found", "--threshold", "high", "-o", "{{ .Status.Critical }}"},
+			wantErr: true,
+			wantOut: "found has 1 vulnerabilities with high or higher severity",
+		},
+		{
+			name:    "acorn image scan invalid threshold",
+			args:    []string{"found", "--threshold", "severe"},
+			wantErr: true,
+			wantOut: `invalid severity "severe", must be one of critical, high, medium, or low`,
+		},
+		{
+			name:    "acorn image scan dne",
+			args:    []string{"dne"},
+			wantErr: true,
+			wantOut: "scanning dne: error: image dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImageScan(commandContext)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
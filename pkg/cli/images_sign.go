@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"crypto"
+	"fmt"
+	"os"
+
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
+	"github.com/acorn-io/acorn/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+func NewImageSign(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSign{client: c.ClientFactory}, cobra.Command{
+		Use: "sign [flags] IMAGE",
+		Example: `# Sign an image with a cosign compatible private key
+acorn image sign my-image:v1 --key ./cosign.key`,
+		SilenceUsage:      true,
+		Short:             "Sign an Image",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type ImageSign struct {
+	client ClientFactory
+	Key    string `usage:"Path to the PEM encoded private key to sign the image with" short:"k"`
+}
+
+func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
+	if a.Key == "" {
+		return fmt.Errorf("--key is required to sign an image")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	key, err := loadSigningKey(a.Key)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.ImageSign(cmd.Context(), args[0], &client.ImageSignOptions{Key: key}); err != nil {
+		return fmt.Errorf("signing %s: %w", args[0], err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
+
+// loadSigningKey reads a private key from disk. The password for encrypted keys is read from
+// the COSIGN_PASSWORD environment variable if it is set, otherwise it is prompted for.
+func loadSigningKey(file string) (crypto.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return imagesignature.LoadPrivateKey(data, func() ([]byte, error) {
+		if pass, ok := os.LookupEnv("COSIGN_PASSWORD"); ok {
+			return []byte(pass), nil
+		}
+		return prompt.Password("Enter password for private key " + file)
+	})
+}
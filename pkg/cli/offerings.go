@@ -15,6 +15,7 @@ acorn offerings`,
 	})
 	cmd.AddCommand(NewVolumeClasses(c))
 	cmd.AddCommand(NewComputeClasses(c))
+	cmd.AddCommand(NewImagePolicies(c))
 	return cmd
 }
 
@@ -26,6 +26,11 @@ acorn project`,
 	cmd.AddCommand(NewProjectCreate(c))
 	cmd.AddCommand(NewProjectRm(c))
 	cmd.AddCommand(NewProjectUse(c))
+	cmd.AddCommand(NewProjectQuota(c))
+	cmd.AddCommand(NewProjectConfig(c))
+	cmd.AddCommand(NewProjectMembers(c))
+	cmd.AddCommand(NewProjectAddMember(c))
+	cmd.AddCommand(NewProjectRemoveMember(c))
 	return cmd
 }
 
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/project"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func NewProjectConfig(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ProjectConfig{client: c.ClientFactory}, cobra.Command{
+		Use: "config [flags] [PROJECT_NAME]",
+		Example: `
+# Show the config overrides of the current project
+acorn project config
+
+# Limit the memory of the workloads of a project to 1Gi
+acorn project config --workload-memory-maximum 1Gi my-project
+
+# Remove the override of the default publish mode
+acorn project config --unset defaultPublishMode my-project
+`,
+		SilenceUsage:      true,
+		Short:             "Show or set the overrides of the cluster config for a project",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, projectsCompletion).complete,
+	})
+	return cmd
+}
+
+type ProjectConfig struct {
+	apiv1.ProjectConfig
+	Unset  []string `usage:"Remove the override of a field of the config, by its JSON name (ex workloadMemoryMaximum)"`
+	Output string   `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" default:"yaml"`
+	client ClientFactory
+}
+
+func (a *ProjectConfig) Run(cmd *cobra.Command, args []string) error {
+	var (
+		c   client.Client
+		err error
+	)
+	if len(args) == 1 {
+		opts := a.client.Options()
+		opts.Project = args[0]
+		c, err = project.Client(cmd.Context(), opts)
+	} else {
+		c, err = a.client.CreateDefault()
+	}
+	if err != nil {
+		return err
+	}
+
+	fields := config.ProjectConfigFields()
+	for _, field := range a.Unset {
+		if !slices.Contains(fields, field) {
+			return fmt.Errorf("invalid --unset %q: must be one of %s", field, strings.Join(fields, ", "))
+		}
+	}
+
+	projectName := c.GetProject()
+	proj, err := c.ProjectGet(cmd.Context(), projectName[strings.LastIndex(projectName, "/")+1:])
+	if err != nil {
+		return err
+	}
+
+	if len(a.Unset) > 0 || !reflect.ValueOf(a.ProjectConfig).IsZero() {
+		if proj.Spec.Config == nil {
+			proj.Spec.Config = &apiv1.ProjectConfig{}
+		}
+		mergeProjectConfig(proj.Spec.Config, a.ProjectConfig, a.Unset)
+		if reflect.ValueOf(*proj.Spec.Config).IsZero() {
+			proj.Spec.Config = nil
+		}
+		if proj, err = c.ProjectUpdate(cmd.Context(), proj); err != nil {
+			return err
+		}
+	}
+
+	projectConfig := proj.Spec.Config
+	if projectConfig == nil {
+		projectConfig = &apiv1.ProjectConfig{}
+	}
+
+	out := table.NewWriter(tables.Info, false, a.Output)
+	out.Write(projectConfig)
+	return out.Err()
+}
+
+// mergeProjectConfig sets the fields of existing that are set in the flags and clears the fields that are unset
+func mergeProjectConfig(existing *apiv1.ProjectConfig, flags apiv1.ProjectConfig, unset []string) {
+	target, source := reflect.ValueOf(existing).Elem(), reflect.ValueOf(flags)
+	for i, field := range config.ProjectConfigFields() {
+		if slices.Contains(unset, field) {
+			target.Field(i).Set(reflect.Zero(target.Field(i).Type()))
+		} else if !source.Field(i).IsZero() {
+			target.Field(i).Set(source.Field(i))
+		}
+	}
+}
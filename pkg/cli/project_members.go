@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/project"
+	"github.com/acorn-io/acorn/pkg/roles"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/spf13/cobra"
+)
+
+func NewProjectMembers(c CommandContext) *cobra.Command {
+	return cli.Command(&ProjectMembers{client: c.ClientFactory}, cobra.Command{
+		Use:     "members [flags] [PROJECT_NAME]",
+		Aliases: []string{"member"},
+		Example: `
+# List the members of the current project
+acorn project members
+
+# List the members of a project
+acorn project members my-project`,
+		SilenceUsage:      true,
+		Short:             "List the members of a project",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, projectsCompletion).complete,
+	})
+}
+
+type ProjectMembers struct {
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *ProjectMembers) Run(cmd *cobra.Command, args []string) error {
+	var (
+		c   client.Client
+		err error
+	)
+	if len(args) == 1 {
+		opts := a.client.Options()
+		opts.Project = args[0]
+		c, err = project.Client(cmd.Context(), opts)
+	} else {
+		c, err = a.client.CreateDefault()
+	}
+	if err != nil {
+		return err
+	}
+
+	memberships, err := c.ProjectMembershipList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ProjectMembership, false, a.Output)
+	for _, membership := range memberships {
+		out.Write(membership)
+	}
+	return out.Err()
+}
+
+func NewProjectAddMember(c CommandContext) *cobra.Command {
+	return cli.Command(&ProjectAddMember{client: c.ClientFactory}, cobra.Command{
+		Use: "add-member [flags] USER_OR_GROUP",
+		Example: `
+# Allow a user to deploy and manage apps in the current project
+acorn project add-member alice@example.com --role edit
+
+# Allow a group to view apps and build images in a project
+acorn -j my-project project add-member developers --group --role view --role build`,
+		SilenceUsage: true,
+		Short:        "Grant roles in a project to a user or group",
+		Long: fmt.Sprintf(`Grant roles in a project to a user or group. If the user or group is already a member of the
+project, its roles are replaced by the roles that are given. The roles are: %s.`, strings.Join(typed.SortedKeys(roles.ProjectRoles), ", ")),
+		Args: cobra.ExactArgs(1),
+	})
+}
+
+type ProjectAddMember struct {
+	Role   []string `usage:"Role to grant in the project (admin, edit, view, view-logs, build)" short:"r"`
+	Group  bool     `usage:"The member is a group instead of a user"`
+	client ClientFactory
+}
+
+func (a *ProjectAddMember) Run(cmd *cobra.Command, args []string) error {
+	if len(a.Role) == 0 {
+		return fmt.Errorf("at least one --role is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	membership, err := c.ProjectMembershipAdd(cmd.Context(), memberKind(a.Group), args[0], a.Role)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(membership.Spec.Name)
+	return nil
+}
+
+func NewProjectRemoveMember(c CommandContext) *cobra.Command {
+	return cli.Command(&ProjectRemoveMember{client: c.ClientFactory}, cobra.Command{
+		Use: "remove-member [flags] USER_OR_GROUP...",
+		Example: `
+acorn project remove-member alice@example.com
+
+acorn project remove-member --group developers`,
+		SilenceUsage: true,
+		Short:        "Remove users or groups from a project",
+		Args:         cobra.MinimumNArgs(1),
+	})
+}
+
+type ProjectRemoveMember struct {
+	Group  bool `usage:"The members are groups instead of users"`
+	client ClientFactory
+}
+
+func (a *ProjectRemoveMember) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		removed, err := c.ProjectMembershipRemove(cmd.Context(), memberKind(a.Group), name)
+		if err != nil {
+			return fmt.Errorf("removing %s: %w", name, err)
+		}
+		if removed != nil {
+			fmt.Println(name)
+		} else {
+			fmt.Printf("Error: No such member: %s\n", name)
+		}
+	}
+
+	return nil
+}
+
+func memberKind(group bool) string {
+	if group {
+		return v1.ProjectMemberKindGroup
+	}
+	return v1.ProjectMemberKindUser
+}
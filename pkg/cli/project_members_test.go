@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProjectMembers(t *testing.T) {
+	memberships := []apiv1.ProjectMembership{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-alice"},
+			Spec: v1.ProjectMembershipSpec{
+				Kind:  v1.ProjectMemberKindUser,
+				Name:  "alice@example.com",
+				Roles: []string{v1.ProjectRoleEdit, v1.ProjectRoleBuild},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "group-developers"},
+			Spec: v1.ProjectMembershipSpec{
+				Kind:  v1.ProjectMemberKindGroup,
+				Name:  "developers",
+				Roles: []string{v1.ProjectRoleView},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		cmd     func(CommandContext) *cobra.Command
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn project members",
+			cmd:     NewProjectMembers,
+			args:    []string{},
+			wantOut: "NAME                KIND      ROLES         CREATED\nalice@example.com   User      edit, build   292y ago\ndevelopers          Group     view          292y ago\n",
+		},
+		{
+			name:    "acorn project add-member",
+			cmd:     NewProjectAddMember,
+			args:    []string{"--role", "edit", "bob@example.com"},
+			wantOut: "bob@example.com\n",
+		},
+		{
+			name:    "acorn project add-member without role",
+			cmd:     NewProjectAddMember,
+			args:    []string{"bob@example.com"},
+			wantErr: "at least one --role is required",
+		},
+		{
+			name:    "acorn project remove-member",
+			cmd:     NewProjectRemoveMember,
+			args:    []string{"--group", "developers", "alice@example.com"},
+			wantOut: "developers\nError: No such member: alice@example.com\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := tt.cmd(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{MembershipList: memberships},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			if tt.wantErr == "" {
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/project"
+	"github.com/acorn-io/acorn/pkg/quota"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const quotaUnlimited = "unlimited"
+
+func NewProjectQuota(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ProjectQuota{client: c.ClientFactory}, cobra.Command{
+		Use: "quota [flags] [PROJECT_NAME]",
+		Example: `
+# Show the usage and the quota of the current project
+acorn project quota
+
+# Limit the project to 5 apps with 4Gi of memory in total
+acorn project quota --apps 5 --memory 4Gi my-project
+
+# Remove the limit of the memory
+acorn project quota --memory unlimited my-project
+`,
+		SilenceUsage:      true,
+		Short:             "Show or set the resource quota of a project",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, projectsCompletion).complete,
+	})
+	return cmd
+}
+
+type ProjectQuota struct {
+	Apps               string `usage:"Maximum number of apps (or unlimited)"`
+	Memory             string `usage:"Maximum total memory of all workloads, e.g. 4Gi (or unlimited)"`
+	CPU                string `usage:"Maximum total CPU of all workloads, e.g. 2 or 500m (or unlimited)" name:"cpu"`
+	VolumeStorage      string `usage:"Maximum total size of all volumes, e.g. 100Gi (or unlimited)"`
+	PublishedEndpoints string `usage:"Maximum number of published endpoints (or unlimited)"`
+	ContainerReplicas  string `usage:"Maximum number of replicas of a single container (or unlimited)"`
+	Output             string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client             ClientFactory
+}
+
+type projectQuotaEntry struct {
+	Resource string `json:"resource,omitempty"`
+	Used     string `json:"used,omitempty"`
+	Limit    string `json:"limit,omitempty"`
+}
+
+func (a *ProjectQuota) Run(cmd *cobra.Command, args []string) error {
+	var (
+		c   client.Client
+		err error
+	)
+	if len(args) == 1 {
+		opts := a.client.Options()
+		opts.Project = args[0]
+		c, err = project.Client(cmd.Context(), opts)
+	} else {
+		c, err = a.client.CreateDefault()
+	}
+	if err != nil {
+		return err
+	}
+
+	projectName := c.GetProject()
+	proj, err := c.ProjectGet(cmd.Context(), projectName[strings.LastIndex(projectName, "/")+1:])
+	if err != nil {
+		return err
+	}
+
+	if a.changed() {
+		if proj.Spec.Quota == nil {
+			proj.Spec.Quota = &apiv1.ProjectQuota{}
+		}
+		if err := a.apply(proj.Spec.Quota); err != nil {
+			return err
+		}
+		if *proj.Spec.Quota == (apiv1.ProjectQuota{}) {
+			proj.Spec.Quota = nil
+		}
+		if proj, err = c.ProjectUpdate(cmd.Context(), proj); err != nil {
+			return err
+		}
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+	appInstances := make([]v1.AppInstance, 0, len(apps))
+	for _, app := range apps {
+		appInstances = append(appInstances, v1.AppInstance(app))
+	}
+	usage, err := quota.ForApps(appInstances, nil)
+	if err != nil {
+		return err
+	}
+
+	limits := proj.Spec.Quota
+	if limits == nil {
+		limits = &apiv1.ProjectQuota{}
+	}
+
+	out := table.NewWriter(tables.ProjectQuota, false, a.Output)
+	out.Write(projectQuotaEntry{Resource: "apps", Used: strconv.Itoa(int(usage.Apps)), Limit: countLimit(limits.Apps)})
+	out.Write(projectQuotaEntry{Resource: "memory", Used: usage.Memory.String(), Limit: quantityLimit(limits.Memory)})
+	out.Write(projectQuotaEntry{Resource: "cpu", Used: usage.CPU.String(), Limit: quantityLimit(limits.CPU)})
+	out.Write(projectQuotaEntry{Resource: "volume-storage", Used: usage.VolumeStorage.String(), Limit: quantityLimit(limits.VolumeStorage)})
+	out.Write(projectQuotaEntry{Resource: "published-endpoints", Used: strconv.Itoa(int(usage.PublishedEndpoints)), Limit: countLimit(limits.PublishedEndpoints)})
+	out.Write(projectQuotaEntry{Resource: "container-replicas", Used: strconv.Itoa(int(usage.ContainerReplicas)), Limit: countLimit(limits.ContainerReplicas)})
+	return out.Err()
+}
+
+func (a *ProjectQuota) changed() bool {
+	return a.Apps != "" || a.Memory != "" || a.CPU != "" || a.VolumeStorage != "" || a.PublishedEndpoints != "" || a.ContainerReplicas != ""
+}
+
+func (a *ProjectQuota) apply(q *apiv1.ProjectQuota) (err error) {
+	if q.Apps, err = parseCountLimit("apps", a.Apps, q.Apps); err != nil {
+		return err
+	}
+	if q.Memory, err = parseQuantityLimit("memory", a.Memory, q.Memory); err != nil {
+		return err
+	}
+	if q.CPU, err = parseQuantityLimit("cpu", a.CPU, q.CPU); err != nil {
+		return err
+	}
+	if q.VolumeStorage, err = parseQuantityLimit("volume-storage", a.VolumeStorage, q.VolumeStorage); err != nil {
+		return err
+	}
+	if q.PublishedEndpoints, err = parseCountLimit("published-endpoints", a.PublishedEndpoints, q.PublishedEndpoints); err != nil {
+		return err
+	}
+	q.ContainerReplicas, err = parseCountLimit("container-replicas", a.ContainerReplicas, q.ContainerReplicas)
+	return err
+}
+
+func parseCountLimit(flag, value string, existing *int32) (*int32, error) {
+	switch value {
+	case "":
+		return existing, nil
+	case quotaUnlimited:
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || i < 0 {
+		return nil, fmt.Errorf("invalid --%s %q: must be a non-negative number or %s", flag, value, quotaUnlimited)
+	}
+	result := int32(i)
+	return &result, nil
+}
+
+func parseQuantityLimit(flag, value string, existing *resource.Quantity) (*resource.Quantity, error) {
+	switch value {
+	case "":
+		return existing, nil
+	case quotaUnlimited:
+		return nil, nil
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil || q.Sign() < 0 {
+		return nil, fmt.Errorf("invalid --%s %q: must be a non-negative quantity or %s", flag, value, quotaUnlimited)
+	}
+	return &q, nil
+}
+
+func countLimit(limit *int32) string {
+	if limit == nil {
+		return quotaUnlimited
+	}
+	return strconv.Itoa(int(*limit))
+}
+
+func quantityLimit(limit *resource.Quantity) string {
+	if limit == nil {
+		return quotaUnlimited
+	}
+	return limit.String()
+}
@@ -22,6 +22,7 @@ func NewPush(c CommandContext) *cobra.Command {
 
 type Push struct {
 	client ClientFactory
+	Key    string `usage:"Path to the PEM encoded private key to sign the image with before pushing" short:"k"`
 }
 
 func (s *Push) Run(cmd *cobra.Command, args []string) error {
@@ -50,6 +51,16 @@ func (s *Push) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if s.Key != "" {
+		key, err := loadSigningKey(s.Key)
+		if err != nil {
+			return err
+		}
+		if _, err := c.ImageSign(cmd.Context(), args[0], &client.ImageSignOptions{Key: key}); err != nil {
+			return err
+		}
+	}
+
 	prog, err := c.ImagePush(cmd.Context(), args[0], &client.ImagePushOptions{
 		Auth: auth,
 	})
@@ -36,6 +36,9 @@ func NewRun(c CommandContext) *cobra.Command {
   # Publish container "myapp" using the hostname app.example.com
   acorn run --publish app.example.com:myapp .
 
+  # Serve container "myapp" on the custom domain app.example.com once its ownership is verified
+  acorn run --domain app.example.com:myapp .
+
   # Expose port 80 to the rest of the cluster as port 8080
   acorn run --expose 8080:80/http .
 
@@ -109,11 +112,13 @@ func NewRun(c CommandContext) *cobra.Command {
 
 type Run struct {
 	RunArgs
-	Interactive       bool  `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i" name:"dev"`
-	BidirectionalSync bool  `usage:"In interactive mode download changes in addition to uploading" short:"b"`
-	Wait              *bool `usage:"Wait for app to become ready before command exiting (default true)"`
-	Quiet             bool  `usage:"Do not print status" short:"q"`
-	Update            bool  `usage:"Update the app if it already exists" short:"u"`
+	Interactive       bool   `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i" name:"dev"`
+	BidirectionalSync bool   `usage:"In interactive mode download changes in addition to uploading" short:"b"`
+	TUI               bool   `usage:"In interactive mode show a terminal UI with container status, per-container logs, build and sync progress"`
+	Workspace         string `usage:"In interactive mode build and run all apps of a workspace file, linking them to each other"`
+	Wait              *bool  `usage:"Wait for app to become ready before command exiting (default true)"`
+	Quiet             bool   `usage:"Do not print status" short:"q"`
+	Update            bool   `usage:"Update the app if it already exists" short:"u"`
 
 	out    io.Writer
 	client ClientFactory
@@ -127,6 +132,7 @@ type RunArgs struct {
 	Link            []string `usage:"Link external app as a service in the current app (format app-name:container-name)"`
 	PublishAll      *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
 	Publish         []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Domain          []string `usage:"Serve a container or router on a custom domain once its ownership is verified with a TXT record (format domain:container-name) (ex api.example.com:web)"`
 	Expose          []string `usage:"In cluster expose ports of an application (format [public:]private) (ex 81:80)"`
 	Profile         []string `usage:"Profile to assign default values"`
 	Env             []string `usage:"Environment variables to set on running containers" short:"e"`
@@ -138,6 +144,8 @@ type RunArgs struct {
 	NotifyUpgrade   *bool    `usage:"If true and the app is configured for auto-upgrades, you will be notified in the CLI when an upgrade is available and must confirm it"`
 	AutoUpgrade     *bool    `usage:"Enabled automatic upgrades."`
 	Interval        string   `usage:"If configured for auto-upgrade, this is the time interval at which to check for new releases (ex: 1h, 5m)"`
+	UpgradeWindow   []string `usage:"If configured for auto-upgrade, only apply upgrades during this window (format days:hours[:timezone]) (ex mon-fri:2-4:America/Chicago)"`
+	UpgradeSoak     string   `usage:"If configured for auto-upgrade, revert an upgrade unless the app stays ready for this long (ex: 10m)"`
 	Memory          []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
 	ComputeClass    []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
 }
@@ -155,6 +163,17 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 	opts.NotifyUpgrade = s.NotifyUpgrade
 	opts.AutoUpgradeInterval = s.Interval
 
+	windows, err := v1.ParseUpgradeWindows(s.UpgradeWindow)
+	if err != nil {
+		return opts, err
+	}
+	if len(windows) > 0 || s.UpgradeSoak != "" {
+		opts.UpgradePolicy = &v1.UpgradePolicy{
+			Windows:    windows,
+			SoakPeriod: s.UpgradeSoak,
+		}
+	}
+
 	opts.Memory, err = v1.ParseMemory(s.Memory)
 	if err != nil {
 		return opts, err
@@ -180,6 +199,11 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.Domains, err = v1.ParseDomains(s.Domain)
+	if err != nil {
+		return opts, err
+	}
+
 	opts.Env = v1.ParseNameValues(true, s.Env...)
 
 	opts.Labels, err = v1.ParseScopedLabels(s.Label...)
@@ -257,6 +281,15 @@ func (s *Run) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if s.Workspace != "" {
+		if !s.Interactive {
+			return fmt.Errorf("--workspace requires --dev/-i")
+		}
+		if s.Update || s.Name != "" || len(args) > 0 {
+			return fmt.Errorf("cannot use --update/-u, --name/-n or arguments with --workspace, they are set per app in the workspace file")
+		}
+	}
+
 	// Update mode -> early exits on invalid combinations
 	if s.Update {
 		if s.Interactive {
@@ -282,6 +315,17 @@ func (s *Run) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if s.Workspace != "" {
+		return dev.DevWorkspace(cmd.Context(), c, s.Workspace, &dev.Options{
+			Build: client.AcornImageBuildOptions{
+				Profiles: opts.Profiles,
+			},
+			Run:               opts,
+			Dangerous:         s.Dangerous,
+			BidirectionalSync: s.BidirectionalSync,
+		})
+	}
+
 	cwd := "."
 	if len(args) > 0 {
 		cwd = args[0]
@@ -303,6 +347,7 @@ func (s *Run) Run(cmd *cobra.Command, args []string) error {
 			Run:               opts,
 			Dangerous:         s.Dangerous,
 			BidirectionalSync: s.BidirectionalSync,
+			TUI:               s.TUI,
 		})
 	}
 
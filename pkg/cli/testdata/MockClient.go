@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
@@ -47,6 +48,10 @@ type MockClientFactory struct {
 	VolumeClassItem  *apiv1.VolumeClass
 	ComputeClassList []apiv1.ComputeClass
 	ComputeClassItem *apiv1.ComputeClass
+	ImagePolicyList  []apiv1.ImagePolicy
+	WebhookList      []apiv1.UpgradeWebhook
+	MembershipList   []apiv1.ProjectMembership
+	AuditEntryList   []apiv1.AuditEntry
 }
 
 func (dc *MockClientFactory) Options() project.Options {
@@ -73,6 +78,10 @@ func (dc *MockClientFactory) CreateDefault() (client.Client, error) {
 		VolumeClassItem:  dc.VolumeClassItem,
 		ComputeClasses:   dc.ComputeClassList,
 		ComputeClassItem: dc.ComputeClassItem,
+		ImagePolicies:    dc.ImagePolicyList,
+		Webhooks:         dc.WebhookList,
+		Memberships:      dc.MembershipList,
+		AuditEntries:     dc.AuditEntryList,
 	}, nil
 }
 
@@ -95,6 +104,10 @@ type MockClient struct {
 	VolumeClassItem  *apiv1.VolumeClass
 	ComputeClasses   []apiv1.ComputeClass
 	ComputeClassItem *apiv1.ComputeClass
+	ImagePolicies    []apiv1.ImagePolicy
+	Webhooks         []apiv1.UpgradeWebhook
+	Memberships      []apiv1.ProjectMembership
+	AuditEntries     []apiv1.AuditEntry
 }
 
 func (m *MockClient) AppPullImage(ctx context.Context, name string) error {
@@ -440,6 +453,10 @@ func (m *MockClient) ContainerReplicaExec(ctx context.Context, name string, args
 	return nil, nil
 }
 
+func (m *MockClient) ContainerReplicaPortForward(ctx context.Context, name string, port int) (client.PortForwardDialer, error) {
+	return nil, nil
+}
+
 func (m *MockClient) VolumeList(ctx context.Context) ([]apiv1.Volume, error) {
 	if m.Volumes != nil {
 		return m.Volumes, nil
@@ -574,22 +591,75 @@ func (m *MockClient) ImageTag(ctx context.Context, image, tag string) error {
 	return nil
 }
 
+func (m *MockClient) ImageSign(ctx context.Context, image string, opts *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
+	switch image {
+	case "dne":
+		return nil, fmt.Errorf("error: image %s does not exist", image)
+	}
+	return &apiv1.ImageSignature{}, nil
+}
+
 func (m *MockClient) ImageDetails(ctx context.Context, imageName string, opts *client.ImageDetailsOptions) (*client.ImageDetails, error) {
 	return &client.ImageDetails{
 		AppImage: v1.AppImage{ID: imageName, ImageData: v1.ImagesData{
 			Containers: map[string]v1.ContainerData{"test-image-running-container": v1.ContainerData{
-				Image:    "test-image-running-container",
-				Sidecars: nil,
+				Image:     "test-image-running-container",
+				Platforms: []v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}},
+				Sidecars:  nil,
 			}},
 			Jobs:   nil,
 			Images: nil,
-		}},
+		}, Platforms: []v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}},
 		AppSpec:    nil,
 		Params:     nil,
 		ParseError: "",
 	}, nil
 }
 
+func (m *MockClient) ImageScan(ctx context.Context, imageName string) (*apiv1.ImageScan, error) {
+	switch imageName {
+	case "dne":
+		return nil, fmt.Errorf("error: image %s does not exist", imageName)
+	}
+	return &apiv1.ImageScan{
+		ObjectMeta: metav1.ObjectMeta{Name: imageName},
+		Status: v1.ImageScanStatus{
+			Scanner:  "file",
+			Digest:   "sha256:1234567890",
+			Critical: 1,
+			Low:      1,
+		},
+		Vulnerabilities: []v1.Vulnerability{
+			{
+				ID:               "CVE-2023-0001",
+				Package:          "openssl",
+				InstalledVersion: "1.1.1",
+				FixedVersion:     "1.1.2",
+				Severity:         v1.SeverityCritical,
+				Title:            "openssl bug",
+			},
+			{
+				ID:               "CVE-2023-0002",
+				Package:          "zlib",
+				InstalledVersion: "1.2.3",
+				Severity:         v1.SeverityLow,
+			},
+		},
+	}, nil
+}
+
+func (m *MockClient) ImagePrune(ctx context.Context, opts *client.ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	return &apiv1.ImagePrune{
+		DryRun: opts != nil && opts.DryRun,
+		Images: []apiv1.Image{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"},
+				Digest:     "sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef",
+			},
+		},
+	}, nil
+}
+
 func (m *MockClient) BuilderCreate(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
 
 func (m *MockClient) BuilderGet(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
@@ -676,6 +746,55 @@ func (m *MockClient) ComputeClassGet(_ context.Context, name string) (*apiv1.Com
 	}, name)
 }
 
+func (m *MockClient) ImagePolicyList(_ context.Context) ([]apiv1.ImagePolicy, error) {
+	return m.ImagePolicies, nil
+}
+
+func (m *MockClient) ImagePolicyGet(_ context.Context, name string) (*apiv1.ImagePolicy, error) {
+	for _, s := range m.ImagePolicies {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    "api.acorn.io",
+		Resource: "imagepolicies",
+	}, name)
+}
+
+func (m *MockClient) UpgradeWebhookCreate(_ context.Context, name string, spec v1.UpgradeWebhookSpec) (*apiv1.UpgradeWebhook, error) {
+	return &apiv1.UpgradeWebhook{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}, nil
+}
+
+func (m *MockClient) UpgradeWebhookList(_ context.Context) ([]apiv1.UpgradeWebhook, error) {
+	return m.Webhooks, nil
+}
+
+func (m *MockClient) UpgradeWebhookGet(_ context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	for _, w := range m.Webhooks {
+		if w.Name == name {
+			return &w, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    "api.acorn.io",
+		Resource: "upgradewebhooks",
+	}, name)
+}
+
+func (m *MockClient) UpgradeWebhookDelete(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	w, err := m.UpgradeWebhookGet(ctx, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return w, err
+}
+
 func (m *MockClient) GetProject() string {
 	if m.ProjectItem != nil {
 		return m.ProjectItem.Name
@@ -695,6 +814,39 @@ func (m *MockClient) ProjectCreate(ctx context.Context, name string) (*apiv1.Pro
 	panic("implement me")
 }
 
+func (m *MockClient) ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error) {
+	m.ProjectItem = project
+	return project, nil
+}
+
+func (m *MockClient) ProjectMembershipList(_ context.Context) ([]apiv1.ProjectMembership, error) {
+	return m.Memberships, nil
+}
+
+func (m *MockClient) AuditEntryList(_ context.Context) ([]apiv1.AuditEntry, error) {
+	return m.AuditEntries, nil
+}
+
+func (m *MockClient) ProjectMembershipAdd(_ context.Context, kind, name string, roles []string) (*apiv1.ProjectMembership, error) {
+	return &apiv1.ProjectMembership{
+		ObjectMeta: metav1.ObjectMeta{Name: strings.ToLower(kind) + "-" + name},
+		Spec: v1.ProjectMembershipSpec{
+			Kind:  kind,
+			Name:  name,
+			Roles: roles,
+		},
+	}, nil
+}
+
+func (m *MockClient) ProjectMembershipRemove(_ context.Context, kind, name string) (*apiv1.ProjectMembership, error) {
+	for _, membership := range m.Memberships {
+		if membership.Spec.Kind == kind && membership.Spec.Name == name {
+			return &membership, nil
+		}
+	}
+	return nil, nil
+}
+
 func (m *MockClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error) {
 	// TODO implement me
 	panic("implement me")
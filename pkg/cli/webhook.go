@@ -0,0 +1,54 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/acorn-io/acorn/pkg/cli/builder/table"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewWebhook(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Webhook{client: c.ClientFactory}, cobra.Command{
+		Use:     "webhook [flags] [WEBHOOK_NAME...]",
+		Aliases: []string{"webhooks", "wh"},
+		Example: `
+acorn webhook
+
+# Show the delivery log of a webhook
+acorn webhook -o yaml my-webhook`,
+		SilenceUsage: true,
+		Short:        "Manage webhooks that are notified of app upgrades",
+	})
+	cmd.AddCommand(NewWebhookCreate(c))
+	cmd.AddCommand(NewWebhookDelete(c))
+	return cmd
+}
+
+type Webhook struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *Webhook) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.UpgradeWebhook, a.Quiet, a.Output)
+
+	webhooks, err := c.UpgradeWebhookList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if len(args) == 0 || slices.Contains(args, webhook.Name) {
+			out.Write(webhook)
+		}
+	}
+
+	return out.Err()
+}
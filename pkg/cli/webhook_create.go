@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookCreate(c CommandContext) *cobra.Command {
+	return cli.Command(&WebhookCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] WEBHOOK_NAME",
+		Example: `
+# Post upgrade events as JSON signed with the "secret" key of the secret named webhook-key
+acorn secret create --data secret=mysigningkey webhook-key
+acorn webhook create --url https://example.com/hooks/acorn --secret webhook-key my-webhook
+
+# Post a Slack message when an upgrade is available
+acorn webhook create --url https://hooks.slack.com/services/XXX --format slack --event upgrade.available slack`,
+		SilenceUsage: true,
+		Short:        "Create a webhook that is notified of app upgrades",
+		Args:         cobra.ExactArgs(1),
+	})
+}
+
+type WebhookCreate struct {
+	URL    string   `usage:"URL to post events to"`
+	Format string   `usage:"Payload format (json, slack)" default:"json"`
+	Secret string   `usage:"Name of a secret whose \"secret\" key is used to sign payloads with HMAC-SHA256"`
+	Event  []string `usage:"Events to send (upgrade.available, upgrade.applied), all events are sent if not set"`
+	client ClientFactory
+}
+
+func (a *WebhookCreate) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	webhook, err := c.UpgradeWebhookCreate(cmd.Context(), args[0], v1.UpgradeWebhookSpec{
+		URL:        a.URL,
+		Format:     a.Format,
+		SecretName: a.Secret,
+		Events:     a.Event,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(webhook.Name)
+	return nil
+}
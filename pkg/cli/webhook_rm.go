@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/acorn/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookDelete(c CommandContext) *cobra.Command {
+	return cli.Command(&WebhookDelete{client: c.ClientFactory}, cobra.Command{
+		Use: "rm [WEBHOOK_NAME...]",
+		Example: `
+acorn webhook rm my-webhook`,
+		SilenceUsage: true,
+		Short:        "Delete a webhook",
+	})
+}
+
+type WebhookDelete struct {
+	client ClientFactory
+}
+
+func (a *WebhookDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		deleted, err := c.UpgradeWebhookDelete(cmd.Context(), name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", name, err)
+		}
+		if deleted != nil {
+			fmt.Println(name)
+		} else {
+			fmt.Printf("Error: No such webhook: %s\n", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWebhook(t *testing.T) {
+	webhooks := []apiv1.UpgradeWebhook{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci"},
+			Spec: v1.UpgradeWebhookSpec{
+				URL: "https://example.com/hook",
+			},
+			Status: v1.UpgradeWebhookStatus{
+				Deliveries: []v1.UpgradeWebhookDelivery{{StatusCode: 200}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+			Spec: v1.UpgradeWebhookSpec{
+				URL:    "https://hooks.slack.com/services/x",
+				Format: v1.UpgradeWebhookFormatSlack,
+				Events: []string{v1.UpgradeEventAvailable},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name:    "acorn webhook",
+			args:    []string{},
+			wantOut: "NAME      URL                                  FORMAT    EVENTS              LAST-DELIVERY   CREATED\nci        https://example.com/hook             json      all                 200 292y ago    292y ago\nslack     https://hooks.slack.com/services/x   slack     upgrade.available                   292y ago\n",
+		},
+		{
+			name:    "acorn webhook with arg",
+			args:    []string{"--", "slack"},
+			wantOut: "NAME      URL                                  FORMAT    EVENTS              LAST-DELIVERY   CREATED\nslack     https://hooks.slack.com/services/x   slack     upgrade.available                   292y ago\n",
+		},
+		{
+			name:    "acorn webhook -q",
+			args:    []string{"-q"},
+			wantOut: "ci\nslack\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewWebhook(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{WebhookList: webhooks},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.NoError(t, cmd.Execute())
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
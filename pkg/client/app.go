@@ -58,8 +58,10 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			AutoUpgrade:         opts.AutoUpgrade,
 			NotifyUpgrade:       opts.NotifyUpgrade,
 			AutoUpgradeInterval: opts.AutoUpgradeInterval,
+			UpgradePolicy:       opts.UpgradePolicy,
 			Memory:              opts.Memory,
 			ComputeClass:        opts.ComputeClass,
+			Domains:             opts.Domains,
 		},
 	}
 }
@@ -111,6 +113,7 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	app.Spec.Secrets = mergeSecrets(app.Spec.Secrets, opts.Secrets)
 	app.Spec.Links = mergeServices(app.Spec.Links, opts.Links)
 	app.Spec.Ports = mergePorts(app.Spec.Ports, opts.Ports)
+	app.Spec.Domains = mergeDomains(app.Spec.Domains, opts.Domains)
 	app.Spec.Environment = mergeEnv(app.Spec.Environment, opts.Env)
 	app.Spec.Labels = mergeLabels(app.Spec.Labels, opts.Labels)
 	app.Spec.Annotations = mergeLabels(app.Spec.Annotations, opts.Annotations)
@@ -139,6 +142,9 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	if opts.AutoUpgradeInterval != "" {
 		app.Spec.AutoUpgradeInterval = opts.AutoUpgradeInterval
 	}
+	if opts.UpgradePolicy != nil {
+		app.Spec.UpgradePolicy = mergeUpgradePolicy(app.Spec.UpgradePolicy, opts.UpgradePolicy)
+	}
 	if len(opts.Memory) != 0 {
 		app.Spec.Memory = opts.Memory
 	}
@@ -282,6 +288,24 @@ func mergeServices(appServices, optsServices []v1.ServiceBinding) []v1.ServiceBi
 	return appServices
 }
 
+func mergeDomains(appDomains, optsDomains []v1.DomainBinding) []v1.DomainBinding {
+	for _, newDomain := range optsDomains {
+		found := false
+		for i, existingDomain := range appDomains {
+			if existingDomain.Domain == newDomain.Domain {
+				appDomains[i] = newDomain
+				found = true
+				break
+			}
+		}
+		if !found {
+			appDomains = append(appDomains, newDomain)
+		}
+	}
+
+	return appDomains
+}
+
 func mergeSecrets(appSecrets, optsSecrets []v1.SecretBinding) []v1.SecretBinding {
 	for _, newSecret := range optsSecrets {
 		found := false
@@ -337,6 +361,20 @@ func mergeLabels(appLabels, optsLabels []v1.ScopedLabel) []v1.ScopedLabel {
 	return appLabels
 }
 
+func mergeUpgradePolicy(appPolicy, optsPolicy *v1.UpgradePolicy) *v1.UpgradePolicy {
+	result := v1.UpgradePolicy{}
+	if appPolicy != nil {
+		result = *appPolicy
+	}
+	if len(optsPolicy.Windows) > 0 {
+		result.Windows = optsPolicy.Windows
+	}
+	if optsPolicy.SoakPeriod != "" {
+		result.SoakPeriod = optsPolicy.SoakPeriod
+	}
+	return &result
+}
+
 func appScoped(scoped []v1.ScopedLabel) map[string]string {
 	labels := make(map[string]string)
 	for _, s := range scoped {
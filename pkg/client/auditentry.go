@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AuditEntryList returns the recent audit entries of the project, oldest first
+func (c *DefaultClient) AuditEntryList(ctx context.Context) ([]apiv1.AuditEntry, error) {
+	result := &apiv1.AuditEntryList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return result.Items[i].CreationTimestamp.Before(&result.Items[j].CreationTimestamp)
+	})
+
+	return result.Items, nil
+}
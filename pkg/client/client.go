@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"crypto"
+	"net"
 	"os"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
@@ -95,8 +97,10 @@ type AppUpdateOptions struct {
 	AutoUpgrade         *bool
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
+	UpgradePolicy       *v1.UpgradePolicy
 	Memory              v1.MemoryMap
 	ComputeClass        v1.ComputeClassMap
+	Domains             []v1.DomainBinding
 }
 
 type LogOptions apiv1.LogOptions
@@ -119,8 +123,10 @@ type AppRunOptions struct {
 	AutoUpgrade         *bool
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
+	UpgradePolicy       *v1.UpgradePolicy
 	Memory              v1.MemoryMap
 	ComputeClass        v1.ComputeClassMap
+	Domains             []v1.DomainBinding
 }
 
 func (a AppRunOptions) ToUpdate() AppUpdateOptions {
@@ -141,8 +147,10 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		AutoUpgrade:         a.AutoUpgrade,
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
+		UpgradePolicy:       a.UpgradePolicy,
 		Memory:              a.Memory,
 		ComputeClass:        a.ComputeClass,
+		Domains:             a.Domains,
 	}
 }
 
@@ -164,8 +172,10 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		AutoUpgrade:         a.AutoUpgrade,
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
+		UpgradePolicy:       a.UpgradePolicy,
 		Memory:              a.Memory,
 		ComputeClass:        a.ComputeClass,
+		Domains:             a.Domains,
 	}
 }
 
@@ -180,6 +190,8 @@ type ImageDetails struct {
 	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
 	Params     *v1.ParamSpec `json:"params,omitempty"`
 	ParseError string        `json:"parseError,omitempty"`
+
+	ScanStatus *v1.ImageScanStatus `json:"scanStatus,omitempty"`
 }
 
 type Client interface {
@@ -211,6 +223,7 @@ type Client interface {
 	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
 	ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
 	ContainerReplicaExec(ctx context.Context, name string, args []string, tty bool, opts *ContainerReplicaExecOptions) (*term.ExecIO, error)
+	ContainerReplicaPortForward(ctx context.Context, name string, port int) (PortForwardDialer, error)
 
 	VolumeList(ctx context.Context) ([]apiv1.Volume, error)
 	VolumeGet(ctx context.Context, name string) (*apiv1.Volume, error)
@@ -222,7 +235,10 @@ type Client interface {
 	ImagePush(ctx context.Context, tagName string, opts *ImagePushOptions) (<-chan ImageProgress, error)
 	ImagePull(ctx context.Context, name string, opts *ImagePullOptions) (<-chan ImageProgress, error)
 	ImageTag(ctx context.Context, image, tag string) error
+	ImageSign(ctx context.Context, image string, opts *ImageSignOptions) (*apiv1.ImageSignature, error)
 	ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error)
+	ImageScan(ctx context.Context, imageName string) (*apiv1.ImageScan, error)
+	ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error)
 
 	AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error)
 	AcornImageBuildList(ctx context.Context) ([]apiv1.AcornImageBuild, error)
@@ -232,8 +248,15 @@ type Client interface {
 	ProjectGet(ctx context.Context, name string) (*apiv1.Project, error)
 	ProjectList(ctx context.Context) ([]apiv1.Project, error)
 	ProjectCreate(ctx context.Context, name string) (*apiv1.Project, error)
+	ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error)
 	ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error)
 
+	ProjectMembershipList(ctx context.Context) ([]apiv1.ProjectMembership, error)
+	ProjectMembershipAdd(ctx context.Context, kind, name string, roles []string) (*apiv1.ProjectMembership, error)
+	ProjectMembershipRemove(ctx context.Context, kind, name string) (*apiv1.ProjectMembership, error)
+
+	AuditEntryList(ctx context.Context) ([]apiv1.AuditEntry, error)
+
 	VolumeClassList(ctx context.Context) ([]apiv1.VolumeClass, error)
 	VolumeClassGet(ctx context.Context, name string) (*apiv1.VolumeClass, error)
 
@@ -242,6 +265,14 @@ type Client interface {
 	ComputeClassList(ctx context.Context) ([]apiv1.ComputeClass, error)
 	ComputeClassGet(ctx context.Context, name string) (*apiv1.ComputeClass, error)
 
+	ImagePolicyList(ctx context.Context) ([]apiv1.ImagePolicy, error)
+	ImagePolicyGet(ctx context.Context, name string) (*apiv1.ImagePolicy, error)
+
+	UpgradeWebhookCreate(ctx context.Context, name string, spec v1.UpgradeWebhookSpec) (*apiv1.UpgradeWebhook, error)
+	UpgradeWebhookList(ctx context.Context) ([]apiv1.UpgradeWebhook, error)
+	UpgradeWebhookGet(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error)
+	UpgradeWebhookDelete(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error)
+
 	GetProject() string
 	GetNamespace() string
 	GetClient() (kclient.WithWatch, error)
@@ -284,6 +315,10 @@ type ImagePushOptions struct {
 	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
 }
 
+type ImageSignOptions struct {
+	Key crypto.Signer
+}
+
 type ImageDetailsOptions struct {
 	Profiles   []string
 	DeployArgs map[string]any
@@ -292,10 +327,17 @@ type ImageDeleteOptions struct {
 	Force bool `json:"force,omitempty"`
 }
 
+type ImagePruneOptions struct {
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
 type ContainerReplicaExecOptions struct {
 	DebugImage string `json:"debugImage,omitempty"`
 }
 
+// PortForwardDialer opens a new connection to the forwarded port of a container replica
+type PortForwardDialer func(ctx context.Context) (net.Conn, error)
+
 type ContainerReplicaListOptions struct {
 	App string `json:"app,omitempty"`
 }
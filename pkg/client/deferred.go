@@ -206,6 +206,13 @@ func (d *DeferredClient) ContainerReplicaExec(ctx context.Context, name string,
 	return d.Client.ContainerReplicaExec(ctx, name, args, tty, opts)
 }
 
+func (d *DeferredClient) ContainerReplicaPortForward(ctx context.Context, name string, port int) (PortForwardDialer, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ContainerReplicaPortForward(ctx, name, port)
+}
+
 func (d *DeferredClient) VolumeList(ctx context.Context) ([]apiv1.Volume, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -269,6 +276,13 @@ func (d *DeferredClient) ImageTag(ctx context.Context, image, tag string) error
 	return d.Client.ImageTag(ctx, image, tag)
 }
 
+func (d *DeferredClient) ImageSign(ctx context.Context, image string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageSign(ctx, image, opts)
+}
+
 func (d *DeferredClient) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -276,6 +290,20 @@ func (d *DeferredClient) ImageDetails(ctx context.Context, imageName string, opt
 	return d.Client.ImageDetails(ctx, imageName, opts)
 }
 
+func (d *DeferredClient) ImageScan(ctx context.Context, imageName string) (*apiv1.ImageScan, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageScan(ctx, imageName)
+}
+
+func (d *DeferredClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImagePrune(ctx, opts)
+}
+
 func (d *DeferredClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -325,6 +353,13 @@ func (d *DeferredClient) ProjectCreate(ctx context.Context, name string) (*apiv1
 	return d.Client.ProjectCreate(ctx, name)
 }
 
+func (d *DeferredClient) ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectUpdate(ctx, project)
+}
+
 func (d *DeferredClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -332,6 +367,34 @@ func (d *DeferredClient) ProjectDelete(ctx context.Context, name string) (*apiv1
 	return d.Client.ProjectDelete(ctx, name)
 }
 
+func (d *DeferredClient) ProjectMembershipList(ctx context.Context) ([]apiv1.ProjectMembership, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectMembershipList(ctx)
+}
+
+func (d *DeferredClient) AuditEntryList(ctx context.Context) ([]apiv1.AuditEntry, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AuditEntryList(ctx)
+}
+
+func (d *DeferredClient) ProjectMembershipAdd(ctx context.Context, kind, name string, roles []string) (*apiv1.ProjectMembership, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectMembershipAdd(ctx, kind, name, roles)
+}
+
+func (d *DeferredClient) ProjectMembershipRemove(ctx context.Context, kind, name string) (*apiv1.ProjectMembership, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectMembershipRemove(ctx, kind, name)
+}
+
 func (d *DeferredClient) ComputeClassGet(ctx context.Context, name string) (*apiv1.ComputeClass, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -346,6 +409,48 @@ func (d *DeferredClient) ComputeClassList(ctx context.Context) ([]apiv1.ComputeC
 	return d.Client.ComputeClassList(ctx)
 }
 
+func (d *DeferredClient) ImagePolicyGet(ctx context.Context, name string) (*apiv1.ImagePolicy, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImagePolicyGet(ctx, name)
+}
+
+func (d *DeferredClient) ImagePolicyList(ctx context.Context) ([]apiv1.ImagePolicy, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImagePolicyList(ctx)
+}
+
+func (d *DeferredClient) UpgradeWebhookCreate(ctx context.Context, name string, spec v1.UpgradeWebhookSpec) (*apiv1.UpgradeWebhook, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.UpgradeWebhookCreate(ctx, name, spec)
+}
+
+func (d *DeferredClient) UpgradeWebhookList(ctx context.Context) ([]apiv1.UpgradeWebhook, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.UpgradeWebhookList(ctx)
+}
+
+func (d *DeferredClient) UpgradeWebhookGet(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.UpgradeWebhookGet(ctx, name)
+}
+
+func (d *DeferredClient) UpgradeWebhookDelete(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.UpgradeWebhookDelete(ctx, name)
+}
+
 func (d *DeferredClient) Info(ctx context.Context) ([]apiv1.Info, error) {
 	if err := d.create(); err != nil {
 		return nil, err
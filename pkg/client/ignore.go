@@ -143,6 +143,10 @@ func (c IgnoreUninstalled) ContainerReplicaExec(ctx context.Context, name string
 	return c.Client.ContainerReplicaExec(ctx, name, args, tty, opts)
 }
 
+func (c IgnoreUninstalled) ContainerReplicaPortForward(ctx context.Context, name string, port int) (PortForwardDialer, error) {
+	return c.Client.ContainerReplicaPortForward(ctx, name, port)
+}
+
 func (c IgnoreUninstalled) VolumeList(ctx context.Context) ([]apiv1.Volume, error) {
 	return ignoreUninstalled(c.Client.VolumeList(ctx))
 }
@@ -269,6 +273,10 @@ func (c *IgnoreUninstalled) ProjectCreate(ctx context.Context, name string) (*ap
 	})
 }
 
+func (c *IgnoreUninstalled) ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error) {
+	return c.Client.ProjectUpdate(ctx, project)
+}
+
 func (c *IgnoreUninstalled) ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error) {
 	return ignoreUninstalled(c.Client.ProjectDelete(ctx, name))
 }
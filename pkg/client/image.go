@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
 	kclient "github.com/acorn-io/acorn/pkg/k8sclient"
+	"github.com/acorn-io/acorn/pkg/tags"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/gorilla/websocket"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +32,46 @@ func (c *DefaultClient) ImageTag(ctx context.Context, imageName, tag string) err
 	return err
 }
 
+func (c *DefaultClient) ImageSign(ctx context.Context, imageName string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	if opts == nil || opts.Key == nil {
+		return nil, fmt.Errorf("a key is required to sign image %s", imageName)
+	}
+
+	image, err := c.ImageGet(ctx, imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var identity string
+	if !tags.IsLocalReference(imageName) {
+		if ref, err := name.ParseReference(imageName); err == nil {
+			identity = ref.Context().Name()
+		}
+	}
+
+	payload, err := imagesignature.NewPayload(identity, image.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := imagesignature.Sign(opts.Key, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv1.ImageSignature{}
+	err = c.RESTClient.Post().
+		Namespace(image.Namespace).
+		Resource("images").
+		Name(image.Name).
+		SubResource("signature").
+		Body(&apiv1.ImageSignature{
+			Payload:   payload,
+			Signature: sig,
+		}).Do(ctx).Into(result)
+	return result, err
+}
+
 func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error) {
 	imageName = strings.ReplaceAll(imageName, "/", "+")
 
@@ -56,9 +98,37 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		AppSpec:    detailsResult.AppSpec,
 		Params:     detailsResult.Params,
 		ParseError: detailsResult.ParseError,
+		ScanStatus: detailsResult.ScanStatus,
 	}, nil
 }
 
+func (c *DefaultClient) ImageScan(ctx context.Context, imageName string) (*apiv1.ImageScan, error) {
+	result := &apiv1.ImageScan{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("scan").
+		Body(&apiv1.ImageScan{}).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	body := &apiv1.ImagePrune{}
+	if opts != nil {
+		body.DryRun = opts.DryRun
+	}
+
+	result := &apiv1.ImagePrune{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("imageprunes").
+		Body(body).
+		Do(ctx).Into(result)
+	return result, err
+}
+
 func (c *DefaultClient) ImagePull(ctx context.Context, imageName string, opts *ImagePullOptions) (<-chan ImageProgress, error) {
 	body := &apiv1.ImagePull{}
 	if opts != nil {
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/baaah/pkg/router"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) ImagePolicyGet(ctx context.Context, name string) (*apiv1.ImagePolicy, error) {
+	result := &apiv1.ImagePolicy{}
+	err := c.Client.Get(ctx, router.Key(c.Namespace, name), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *DefaultClient) ImagePolicyList(ctx context.Context) ([]apiv1.ImagePolicy, error) {
+	result := &apiv1.ImagePolicyList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{Namespace: c.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		if result.Items[i].Scope != result.Items[j].Scope {
+			return result.Items[i].Scope == apiv1.ImagePolicyScopeProject
+		}
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result.Items, nil
+}
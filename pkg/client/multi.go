@@ -305,6 +305,14 @@ func (m *MultiClient) ContainerReplicaExec(ctx context.Context, name string, arg
 	return exec, err
 }
 
+func (m *MultiClient) ContainerReplicaPortForward(ctx context.Context, name string, port int) (dialer PortForwardDialer, err error) {
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.ContainerReplica, error) {
+		dialer, err = c.ContainerReplicaPortForward(ctx, name, port)
+		return &apiv1.ContainerReplica{}, err
+	})
+	return dialer, err
+}
+
 func (m *MultiClient) VolumeList(ctx context.Context) ([]apiv1.Volume, error) {
 	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.Volume, error) {
 		return c.VolumeList(ctx)
@@ -371,6 +379,14 @@ func (m *MultiClient) ImageTag(ctx context.Context, image, tag string) error {
 	return c.ImageTag(ctx, image, tag)
 }
 
+func (m *MultiClient) ImageSign(ctx context.Context, image string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageSign(ctx, image, opts)
+}
+
 func (m *MultiClient) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (result *ImageDetails, err error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -379,6 +395,22 @@ func (m *MultiClient) ImageDetails(ctx context.Context, imageName string, opts *
 	return c.ImageDetails(ctx, imageName, opts)
 }
 
+func (m *MultiClient) ImageScan(ctx context.Context, imageName string) (*apiv1.ImageScan, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageScan(ctx, imageName)
+}
+
+func (m *MultiClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImagePrune(ctx, opts)
+}
+
 func (m *MultiClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -445,6 +477,42 @@ func (m *MultiClient) ProjectCreate(ctx context.Context, name string) (*apiv1.Pr
 	return c.ProjectCreate(ctx, name)
 }
 
+func (m *MultiClient) ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectUpdate(ctx, project)
+}
+
+func (m *MultiClient) ProjectMembershipList(ctx context.Context) ([]apiv1.ProjectMembership, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.ProjectMembership, error) {
+		return c.ProjectMembershipList(ctx)
+	})
+}
+
+func (m *MultiClient) AuditEntryList(ctx context.Context) ([]apiv1.AuditEntry, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.AuditEntry, error) {
+		return c.AuditEntryList(ctx)
+	})
+}
+
+func (m *MultiClient) ProjectMembershipAdd(ctx context.Context, kind, name string, roles []string) (*apiv1.ProjectMembership, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectMembershipAdd(ctx, kind, name, roles)
+}
+
+func (m *MultiClient) ProjectMembershipRemove(ctx context.Context, kind, name string) (*apiv1.ProjectMembership, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectMembershipRemove(ctx, kind, name)
+}
+
 func (m *MultiClient) ProjectList(ctx context.Context) ([]apiv1.Project, error) {
 	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.Project, error) {
 		projs, err := c.ProjectList(ctx)
@@ -470,6 +538,42 @@ func (m *MultiClient) ComputeClassList(ctx context.Context) ([]apiv1.ComputeClas
 	})
 }
 
+func (m *MultiClient) ImagePolicyGet(ctx context.Context, name string) (*apiv1.ImagePolicy, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.ImagePolicy, error) {
+		return c.ImagePolicyGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) ImagePolicyList(ctx context.Context) ([]apiv1.ImagePolicy, error) {
+	return aggregate(ctx, m.Factory, func(client Client) ([]apiv1.ImagePolicy, error) {
+		return client.ImagePolicyList(ctx)
+	})
+}
+
+func (m *MultiClient) UpgradeWebhookCreate(ctx context.Context, name string, spec v1.UpgradeWebhookSpec) (*apiv1.UpgradeWebhook, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.UpgradeWebhook, error) {
+		return c.UpgradeWebhookCreate(ctx, name, spec)
+	})
+}
+
+func (m *MultiClient) UpgradeWebhookList(ctx context.Context) ([]apiv1.UpgradeWebhook, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.UpgradeWebhook, error) {
+		return c.UpgradeWebhookList(ctx)
+	})
+}
+
+func (m *MultiClient) UpgradeWebhookGet(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.UpgradeWebhook, error) {
+		return c.UpgradeWebhookGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) UpgradeWebhookDelete(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.UpgradeWebhook, error) {
+		return c.UpgradeWebhookDelete(ctx, name)
+	})
+}
+
 func (m *MultiClient) Info(ctx context.Context) ([]apiv1.Info, error) {
 	return aggregateOptionalNaming(ctx, false, m.Factory, func(c Client) ([]apiv1.Info, error) {
 		infos, err := c.Info(ctx)
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"net"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/k8schannel"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/sirupsen/logrus"
+)
+
+func (c *DefaultClient) ContainerReplicaPortForward(ctx context.Context, name string, port int) (PortForwardDialer, error) {
+	con, err := c.ContainerReplicaGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first message of each stream of a port forward holds the port number, so the dialer must skip it
+	dialer, err := k8schannel.NewDialer(c.RESTConfig, true)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.RESTClient.Get().
+		Namespace(con.Namespace).
+		Resource("containerreplicas").
+		Name(con.Name).
+		SubResource("portforward").
+		VersionedParams(&apiv1.ContainerReplicaPortForwardOptions{
+			Port: int32(port),
+		}, scheme.ParameterCodec).URL().String()
+
+	return func(ctx context.Context) (net.Conn, error) {
+		logrus.Debugf("Port forward URL: %s", url)
+		conn, err := dialer.DialContext(ctx, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		return conn.ForStream(0), nil
+	}, nil
+}
@@ -37,6 +37,10 @@ func (c *DefaultClient) ProjectCreate(ctx context.Context, name string) (*apiv1.
 	return project, c.Client.Create(ctx, project)
 }
 
+func (c *DefaultClient) ProjectUpdate(ctx context.Context, project *apiv1.Project) (*apiv1.Project, error) {
+	return project, c.Client.Update(ctx, project)
+}
+
 func (c *DefaultClient) ProjectGet(ctx context.Context, name string) (*apiv1.Project, error) {
 	proj := &apiv1.Project{}
 	return proj, c.Client.Get(ctx, router.Key("", name), proj)
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/projectmembership"
+	"github.com/acorn-io/baaah/pkg/router"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) ProjectMembershipList(ctx context.Context) ([]apiv1.ProjectMembership, error) {
+	result := &apiv1.ProjectMembershipList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		if result.Items[i].Spec.Kind != result.Items[j].Spec.Kind {
+			return result.Items[i].Spec.Kind > result.Items[j].Spec.Kind
+		}
+		return result.Items[i].Spec.Name < result.Items[j].Spec.Name
+	})
+
+	return result.Items, nil
+}
+
+// ProjectMembershipAdd grants the roles in the project to the user or group. The roles replace the roles that the
+// member already has.
+func (c *DefaultClient) ProjectMembershipAdd(ctx context.Context, kind, name string, roles []string) (*apiv1.ProjectMembership, error) {
+	membership := &apiv1.ProjectMembership{}
+	err := c.Client.Get(ctx, router.Key(c.Namespace, projectmembership.Name(kind, name)), membership)
+	if apierrors.IsNotFound(err) {
+		membership = &apiv1.ProjectMembership{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      projectmembership.Name(kind, name),
+				Namespace: c.Namespace,
+			},
+			Spec: v1.ProjectMembershipSpec{
+				Kind:  kind,
+				Name:  name,
+				Roles: roles,
+			},
+		}
+		return membership, c.Client.Create(ctx, membership)
+	} else if err != nil {
+		return nil, err
+	}
+
+	membership.Spec.Roles = roles
+	return membership, c.Client.Update(ctx, membership)
+}
+
+func (c *DefaultClient) ProjectMembershipRemove(ctx context.Context, kind, name string) (*apiv1.ProjectMembership, error) {
+	membership := &apiv1.ProjectMembership{}
+	err := c.Client.Get(ctx, router.Key(c.Namespace, projectmembership.Name(kind, name)), membership)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = c.Client.Delete(ctx, membership)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return membership, err
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/baaah/pkg/router"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) UpgradeWebhookCreate(ctx context.Context, name string, spec v1.UpgradeWebhookSpec) (*apiv1.UpgradeWebhook, error) {
+	webhook := &apiv1.UpgradeWebhook{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+		Spec: spec,
+	}
+	return webhook, c.Client.Create(ctx, webhook)
+}
+
+func (c *DefaultClient) UpgradeWebhookGet(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	result := &apiv1.UpgradeWebhook{}
+	return result, c.Client.Get(ctx, router.Key(c.Namespace, name), result)
+}
+
+func (c *DefaultClient) UpgradeWebhookList(ctx context.Context) ([]apiv1.UpgradeWebhook, error) {
+	result := &apiv1.UpgradeWebhookList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result.Items, nil
+}
+
+func (c *DefaultClient) UpgradeWebhookDelete(ctx context.Context, name string) (*apiv1.UpgradeWebhook, error) {
+	webhook, err := c.UpgradeWebhookGet(ctx, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = c.Client.Delete(ctx, webhook)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return webhook, err
+}
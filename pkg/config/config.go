@@ -30,6 +30,13 @@ var (
 	// DefaultImageCheckIntervalDefault is the default value for the DefaultImageCheckInterval field
 	DefaultImageCheckIntervalDefault = "5m"
 
+	// DefaultImageGCIntervalDefault is the default value for the ImageGCInterval field
+	DefaultImageGCIntervalDefault = "24h"
+	// DefaultAuditLogMaxSize is the default value for the AuditLogMaxSize field
+	DefaultAuditLogMaxSize = 100
+	// DefaultAuditLogMaxBackups is the default value for the AuditLogMaxBackups field
+	DefaultAuditLogMaxBackups = 5
+
 	// Default HttpEndpointPattern set to enable Let's Encrypt
 	DefaultHttpEndpointPattern = "{{hashConcat 8 .Container .App .Namespace | truncate}}.{{.ClusterDomain}}"
 )
@@ -96,6 +103,36 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader) error
 	if c.UseCustomCABundle == nil {
 		c.UseCustomCABundle = new(bool)
 	}
+	if c.VulnerabilityScanner == nil {
+		c.VulnerabilityScanner = new(string)
+	}
+	if c.ImageRetentionCount == nil {
+		c.ImageRetentionCount = new(int)
+	}
+	if c.ImageRetentionMaxAge == nil {
+		c.ImageRetentionMaxAge = new(string)
+	}
+	if c.ImageGCInterval == nil || *c.ImageGCInterval == "" {
+		c.ImageGCInterval = &DefaultImageGCIntervalDefault
+	}
+	if c.AuditLogFile == nil {
+		c.AuditLogFile = new(string)
+	}
+	if c.AuditLogMaxSize == nil || *c.AuditLogMaxSize <= 0 {
+		c.AuditLogMaxSize = &DefaultAuditLogMaxSize
+	}
+	if c.AuditLogMaxBackups == nil {
+		c.AuditLogMaxBackups = &DefaultAuditLogMaxBackups
+	}
+	if c.AuditWebhookURL == nil {
+		c.AuditWebhookURL = new(string)
+	}
+	if c.NetworkPolicies == nil {
+		c.NetworkPolicies = new(bool)
+	}
+	if c.IngressControllerNamespace == nil {
+		c.IngressControllerNamespace = new(string)
+	}
 
 	return nil
 }
@@ -279,6 +316,18 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.LetsEncryptEmail != "" {
 		mergedConfig.LetsEncryptEmail = newConfig.LetsEncryptEmail
 	}
+	if newConfig.ACMEDirectoryURL != nil {
+		mergedConfig.ACMEDirectoryURL = emptyToNil(newConfig.ACMEDirectoryURL)
+	}
+	if newConfig.ACMEEABSecret != nil {
+		mergedConfig.ACMEEABSecret = emptyToNil(newConfig.ACMEEABSecret)
+	}
+	if newConfig.ACMEDNSProvider != nil {
+		mergedConfig.ACMEDNSProvider = emptyToNil(newConfig.ACMEDNSProvider)
+	}
+	if newConfig.ACMEDNSProviderSecret != nil {
+		mergedConfig.ACMEDNSProviderSecret = emptyToNil(newConfig.ACMEDNSProviderSecret)
+	}
 	if newConfig.AutoUpgradeInterval != nil {
 		mergedConfig.AutoUpgradeInterval = newConfig.AutoUpgradeInterval
 	}
@@ -303,6 +352,36 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.UseCustomCABundle != nil {
 		mergedConfig.UseCustomCABundle = newConfig.UseCustomCABundle
 	}
+	if newConfig.VulnerabilityScanner != nil {
+		mergedConfig.VulnerabilityScanner = newConfig.VulnerabilityScanner
+	}
+	if newConfig.ImageRetentionCount != nil {
+		mergedConfig.ImageRetentionCount = newConfig.ImageRetentionCount
+	}
+	if newConfig.ImageRetentionMaxAge != nil {
+		mergedConfig.ImageRetentionMaxAge = newConfig.ImageRetentionMaxAge
+	}
+	if newConfig.ImageGCInterval != nil {
+		mergedConfig.ImageGCInterval = newConfig.ImageGCInterval
+	}
+	if newConfig.AuditLogFile != nil {
+		mergedConfig.AuditLogFile = newConfig.AuditLogFile
+	}
+	if newConfig.AuditLogMaxSize != nil {
+		mergedConfig.AuditLogMaxSize = newConfig.AuditLogMaxSize
+	}
+	if newConfig.AuditLogMaxBackups != nil {
+		mergedConfig.AuditLogMaxBackups = newConfig.AuditLogMaxBackups
+	}
+	if newConfig.AuditWebhookURL != nil {
+		mergedConfig.AuditWebhookURL = newConfig.AuditWebhookURL
+	}
+	if newConfig.NetworkPolicies != nil {
+		mergedConfig.NetworkPolicies = newConfig.NetworkPolicies
+	}
+	if newConfig.IngressControllerNamespace != nil {
+		mergedConfig.IngressControllerNamespace = newConfig.IngressControllerNamespace
+	}
 
 	if len(newConfig.PropagateProjectAnnotations) > 0 && newConfig.PropagateProjectAnnotations[0] == "" {
 		mergedConfig.PropagateProjectAnnotations = nil
@@ -316,9 +395,23 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 		mergedConfig.PropagateProjectLabels = newConfig.PropagateProjectLabels
 	}
 
+	if len(newConfig.LockedProjectConfig) > 0 && newConfig.LockedProjectConfig[0] == "" {
+		mergedConfig.LockedProjectConfig = nil
+	} else if len(newConfig.LockedProjectConfig) > 0 {
+		mergedConfig.LockedProjectConfig = newConfig.LockedProjectConfig
+	}
+
 	return &mergedConfig
 }
 
+// emptyToNil resets a value to its default if it is set to an empty string
+func emptyToNil(s *string) *string {
+	if s != nil && *s == "" {
+		return nil
+	}
+	return s
+}
+
 func Init(ctx context.Context, client kclient.Client) error {
 	cm := &corev1.ConfigMap{}
 	err := client.Get(ctx, router.Key(system.Namespace, system.ConfigName), cm)
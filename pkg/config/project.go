@@ -0,0 +1,115 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/namespace"
+	"github.com/acorn-io/acorn/pkg/podsecurity"
+	"golang.org/x/exp/slices"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ProjectConfigFields returns the JSON names of the fields of the config that projects can override
+func ProjectConfigFields() []string {
+	t := reflect.TypeOf(apiv1.ProjectConfig{})
+	result := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		result = append(result, name)
+	}
+	return result
+}
+
+// ValidateLockedProjectConfig checks that the locked project config fields of the config can be overridden by projects
+func ValidateLockedProjectConfig(cfg *apiv1.Config) error {
+	fields := ProjectConfigFields()
+	for _, field := range cfg.LockedProjectConfig {
+		if !slices.Contains(fields, field) {
+			return fmt.Errorf("invalid locked project config field %q: must be one of %s", field, strings.Join(fields, ", "))
+		}
+	}
+	return nil
+}
+
+// LockedFields returns the JSON names of the fields that the project config overrides but that are locked by the config
+func LockedFields(cfg *apiv1.Config, projectConfig *apiv1.ProjectConfig) (result []string) {
+	if projectConfig == nil {
+		return nil
+	}
+	v := reflect.ValueOf(*projectConfig)
+	for i, field := range ProjectConfigFields() {
+		if !v.Field(i).IsZero() && slices.Contains(cfg.LockedProjectConfig, field) {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// applyProjectConfig overrides the fields of the config that are set in the project config and are not locked
+func applyProjectConfig(cfg *apiv1.Config, projectConfig *apiv1.ProjectConfig) {
+	if projectConfig == nil {
+		return
+	}
+	locked := func(field string) bool {
+		return slices.Contains(cfg.LockedProjectConfig, field)
+	}
+
+	if projectConfig.DefaultPublishMode != "" && !locked("defaultPublishMode") {
+		cfg.DefaultPublishMode = projectConfig.DefaultPublishMode
+	}
+	if projectConfig.HttpEndpointPattern != nil && !locked("httpEndpointPattern") {
+		cfg.HttpEndpointPattern = projectConfig.HttpEndpointPattern
+	}
+	if projectConfig.WorkloadMemoryDefault != nil && !locked("workloadMemoryDefault") {
+		cfg.WorkloadMemoryDefault = projectConfig.WorkloadMemoryDefault
+	}
+	if projectConfig.WorkloadMemoryMaximum != nil && !locked("workloadMemoryMaximum") {
+		cfg.WorkloadMemoryMaximum = projectConfig.WorkloadMemoryMaximum
+	}
+	if projectConfig.AutoUpgradeInterval != nil && !locked("autoUpgradeInterval") {
+		cfg.AutoUpgradeInterval = projectConfig.AutoUpgradeInterval
+	}
+	if projectConfig.PropagateProjectAnnotations != nil && !locked("propagateProjectAnnotations") {
+		cfg.PropagateProjectAnnotations = projectConfig.PropagateProjectAnnotations
+	}
+	if projectConfig.PropagateProjectLabels != nil && !locked("propagateProjectLabels") {
+		cfg.PropagateProjectLabels = projectConfig.PropagateProjectLabels
+	}
+	if podsecurity.IsStricter(projectConfig.PodSecurityEnforceProfile, podsecurity.Profile(cfg)) && !locked("podSecurityEnforceProfile") {
+		cfg.SetPodSecurityEnforceProfile = &[]bool{true}[0]
+		cfg.PodSecurityEnforceProfile = projectConfig.PodSecurityEnforceProfile
+	}
+}
+
+// ProjectConfig returns the config overrides of the project that the namespace belongs to, nil is returned if the
+// namespace does not belong to a project or the project does not override the config
+func ProjectConfig(ctx context.Context, getter kclient.Reader, ns string) (*apiv1.ProjectConfig, error) {
+	project, err := namespace.Project(ctx, getter, ns)
+	if err != nil || project == nil {
+		return nil, err
+	}
+	spec, err := namespace.ProjectSpec(project)
+	if err != nil {
+		return nil, err
+	}
+	return spec.Config, nil
+}
+
+// GetForProject returns the config for the apps in the namespace, which is the cluster config with the overrides
+// of the project that the namespace belongs to layered over it
+func GetForProject(ctx context.Context, getter kclient.Reader, ns string) (*apiv1.Config, error) {
+	cfg, err := Get(ctx, getter)
+	if err != nil {
+		return nil, err
+	}
+	projectConfig, err := ProjectConfig(ctx, getter, ns)
+	if err != nil {
+		return nil, err
+	}
+	applyProjectConfig(cfg, projectConfig)
+	return cfg, nil
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fullProjectConfig() *apiv1.ProjectConfig {
+	pattern, interval := "{{.App}}.{{.ClusterDomain}}", "1m"
+	memoryDefault, memoryMaximum := int64(1024), int64(2048)
+	return &apiv1.ProjectConfig{
+		DefaultPublishMode:          v1.PublishModeNone,
+		HttpEndpointPattern:         &pattern,
+		WorkloadMemoryDefault:       &memoryDefault,
+		WorkloadMemoryMaximum:       &memoryMaximum,
+		AutoUpgradeInterval:         &interval,
+		PropagateProjectAnnotations: []string{"annotation"},
+		PropagateProjectLabels:      []string{"label"},
+		PodSecurityEnforceProfile:   "restricted",
+	}
+}
+
+// configField returns the field of the config with the JSON name
+func configField(cfg *apiv1.Config, name string) reflect.Value {
+	v := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if jsonName, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ","); jsonName == name {
+			return v.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+func TestApplyProjectConfig(t *testing.T) {
+	projectConfig := fullProjectConfig()
+	cfg := &apiv1.Config{}
+	applyProjectConfig(cfg, projectConfig)
+
+	// Every field of the project config must override the field of the config with the same name
+	projectValue := reflect.ValueOf(*projectConfig)
+	for i, name := range ProjectConfigFields() {
+		field := configField(cfg, name)
+		require.Truef(t, field.IsValid(), "config has no field %s", name)
+		assert.Equalf(t, projectValue.Field(i).Interface(), field.Interface(), "field %s is not applied", name)
+	}
+}
+
+func TestApplyProjectConfigLocked(t *testing.T) {
+	interval := "5m"
+	cfg := &apiv1.Config{
+		AutoUpgradeInterval: &interval,
+		LockedProjectConfig: []string{"autoUpgradeInterval", "defaultPublishMode"},
+	}
+	projectConfig := fullProjectConfig()
+	applyProjectConfig(cfg, projectConfig)
+
+	assert.Equal(t, "5m", *cfg.AutoUpgradeInterval)
+	assert.Equal(t, v1.PublishMode(""), cfg.DefaultPublishMode)
+	assert.Equal(t, projectConfig.WorkloadMemoryMaximum, cfg.WorkloadMemoryMaximum)
+	assert.Equal(t, []string{"defaultPublishMode", "autoUpgradeInterval"}, LockedFields(cfg, projectConfig))
+	assert.Empty(t, LockedFields(cfg, &apiv1.ProjectConfig{PropagateProjectLabels: []string{"label"}}))
+}
+
+func TestApplyProjectConfigPodSecurity(t *testing.T) {
+	profile := func(cluster, project string) string {
+		cfg := &apiv1.Config{
+			SetPodSecurityEnforceProfile: &[]bool{cluster != ""}[0],
+			PodSecurityEnforceProfile:    cluster,
+		}
+		applyProjectConfig(cfg, &apiv1.ProjectConfig{PodSecurityEnforceProfile: project})
+		if !*cfg.SetPodSecurityEnforceProfile {
+			return ""
+		}
+		return cfg.PodSecurityEnforceProfile
+	}
+
+	// Projects can only make the profile stricter
+	assert.Equal(t, "restricted", profile("baseline", "restricted"))
+	assert.Equal(t, "restricted", profile("", "restricted"))
+	assert.Equal(t, "restricted", profile("restricted", "baseline"))
+	assert.Equal(t, "baseline", profile("baseline", "privileged"))
+	assert.Equal(t, "baseline", profile("baseline", ""))
+}
+
+func TestValidateLockedProjectConfig(t *testing.T) {
+	assert.NoError(t, ValidateLockedProjectConfig(&apiv1.Config{LockedProjectConfig: ProjectConfigFields()}))
+	assert.Error(t, ValidateLockedProjectConfig(&apiv1.Config{LockedProjectConfig: []string{"clusterDomains"}}))
+}
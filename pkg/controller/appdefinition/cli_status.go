@@ -42,6 +42,17 @@ func message(app *v1.AppInstance) string {
 	if app.Status.ConfirmUpgradeAppImage != "" {
 		return "Upgrade available: " + app.Status.ConfirmUpgradeAppImage
 	}
+	if app.Status.UpgradeSoak != nil {
+		return "Verifying upgrade to " + app.Status.AppImage.Name
+	}
+	if n := len(app.Status.UpgradeHistory); n > 0 {
+		switch last := app.Status.UpgradeHistory[n-1]; last.Decision {
+		case v1.UpgradeDecisionDeferred:
+			return "Upgrade to " + last.Image + " deferred: " + last.Message
+		case v1.UpgradeDecisionReverted:
+			return "Upgrade to " + last.Image + " reverted: " + last.Message
+		}
+	}
 
 	if app.Status.Ready {
 		return "OK"
@@ -169,6 +169,49 @@ func (d *depCheckingResponse) isDepReady(depName string) (ready bool, found bool
 	return false, true
 }
 
+func (d *depCheckingResponse) isStatefulSetReady(depName string) (ready bool, found bool) {
+	var sts appsv1.StatefulSet
+	err := d.req.Get(&sts, d.app.Status.Namespace, depName)
+	if apierrors.IsNotFound(err) {
+		return false, false
+	}
+	if err != nil {
+		// if err just return it as not ready
+		return false, true
+	}
+
+	if sts.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(d.app.Generation)) ||
+		sts.Status.ObservedGeneration != sts.Generation ||
+		sts.Status.CurrentRevision != sts.Status.UpdateRevision ||
+		sts.Status.Replicas != sts.Status.ReadyReplicas ||
+		sts.Status.Replicas != sts.Status.UpdatedReplicas {
+		return false, true
+	}
+
+	return true, true
+}
+
+func (d *depCheckingResponse) isDaemonSetReady(depName string) (ready bool, found bool) {
+	var ds appsv1.DaemonSet
+	err := d.req.Get(&ds, d.app.Status.Namespace, depName)
+	if apierrors.IsNotFound(err) {
+		return false, false
+	}
+	if err != nil {
+		// if err just return it as not ready
+		return false, true
+	}
+
+	if ds.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(d.app.Generation)) ||
+		ds.Status.ObservedGeneration != ds.Generation ||
+		ds.Status.DesiredNumberScheduled != ds.Status.NumberReady ||
+		ds.Status.DesiredNumberScheduled != ds.Status.UpdatedNumberScheduled {
+		return false, true
+	}
+
+	return true, true
+}
+
 type depCheck func(string) (bool, bool)
 
 func (d *depCheckingResponse) checkDeps(deps []string) bool {
@@ -179,7 +222,7 @@ outer:
 				continue outer
 			}
 		}
-		for _, depCheck := range []depCheck{d.isDepReady, d.isJobReady, d.isCronJobReady} {
+		for _, depCheck := range []depCheck{d.isDepReady, d.isStatefulSetReady, d.isDaemonSetReady, d.isJobReady, d.isCronJobReady} {
 			if ready, found := depCheck(depName); found && !ready {
 				return false
 			} else if found && ready {
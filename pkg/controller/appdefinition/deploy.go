@@ -15,6 +15,7 @@ import (
 	"github.com/acorn-io/acorn/pkg/expose"
 	"github.com/acorn-io/acorn/pkg/images"
 	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/podsecurity"
 	"github.com/acorn-io/acorn/pkg/ports"
 	"github.com/acorn-io/acorn/pkg/system"
 	"github.com/acorn-io/acorn/pkg/tags"
@@ -23,9 +24,11 @@ import (
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/rancher/wrangler/pkg/data/convert"
+	name2 "github.com/rancher/wrangler/pkg/name"
 	"golang.org/x/exp/maps"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -62,7 +65,7 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 		}
 	}()
 
-	tag, err := images.GetRuntimePullableImageReference(req.Ctx, req.Client, appInstance.Namespace, appInstance.Status.AppImage.ID)
+	tag, err := images.GetRuntimePullableImageReference(req.Ctx, req.Client, appInstance.Namespace, appInstance.Status.AppImage.ID, appInstance.Status.AppImage.Platforms)
 	if err != nil {
 		return err
 	}
@@ -173,6 +176,24 @@ func toEnv(app *v1.AppInstance, envs []v1.EnvVar, appEnv []v1.NameValue) (result
 	return
 }
 
+// hasDevSync returns true if source files are synced into the container in dev mode
+func hasDevSync(container v1.Container) bool {
+	return container.Dev != nil && container.Dev.Sync != nil && len(container.Dev.Sync.Paths) > 0
+}
+
+// needsAcornHelper returns true if files are synced into the container or one of its sidecars in dev mode
+func needsAcornHelper(container v1.Container) bool {
+	if hasDevSync(container) || hasContextDir(container) {
+		return true
+	}
+	for _, sidecar := range container.Sidecars {
+		if needsAcornHelper(sidecar) {
+			return true
+		}
+	}
+	return false
+}
+
 func hasContextDir(container v1.Container) bool {
 	for _, dir := range container.Dirs {
 		if dir.ContextDir != "" {
@@ -187,13 +208,13 @@ func hasContextDir(container v1.Container) bool {
 	return false
 }
 
-func toContainers(req router.Request, app *v1.AppInstance, tag name.Reference, name string, container v1.Container) ([]corev1.Container, []corev1.Container) {
+func toContainers(req router.Request, app *v1.AppInstance, tag name.Reference, podSecurityProfile, name string, container v1.Container) ([]corev1.Container, []corev1.Container) {
 	var (
 		containers     []corev1.Container
 		initContainers []corev1.Container
 	)
 
-	if app.Spec.GetDevMode() && hasContextDir(container) {
+	if app.Spec.GetDevMode() && needsAcornHelper(container) {
 		initContainers = append(initContainers, corev1.Container{
 			Name:            "acorn-helper",
 			Image:           system.DefaultImage(),
@@ -208,10 +229,10 @@ func toContainers(req router.Request, app *v1.AppInstance, tag name.Reference, n
 		})
 	}
 
-	newContainer := toContainer(req, app, tag, name, name, container)
+	newContainer := toContainer(req, app, tag, podSecurityProfile, name, name, container)
 	containers = append(containers, newContainer)
 	for _, entry := range typed.Sorted(container.Sidecars) {
-		newContainer = toContainer(req, app, tag, name, entry.Key, entry.Value)
+		newContainer = toContainer(req, app, tag, podSecurityProfile, name, entry.Key, entry.Value)
 
 		if entry.Value.Init {
 			initContainers = append(initContainers, newContainer)
@@ -281,6 +302,12 @@ func toMounts(app *v1.AppInstance, deploymentName, containerName string, contain
 			})
 		}
 	}
+	if !helperMounted && app.Spec.GetDevMode() && hasDevSync(container) {
+		result = append(result, corev1.VolumeMount{
+			Name:      sanitizeVolumeName(AcornHelper),
+			MountPath: AcornHelperPath,
+		})
+	}
 	return
 }
 
@@ -404,23 +431,34 @@ func toProbe(container v1.Container, probeType v1.ProbeType) *corev1.Probe {
 	return nil
 }
 
-func toContainer(req router.Request, app *v1.AppInstance, tag name.Reference, deploymentName, containerName string, container v1.Container) corev1.Container {
+func toContainer(req router.Request, app *v1.AppInstance, tag name.Reference, podSecurityProfile, deploymentName, containerName string, container v1.Container) corev1.Container {
 	containerObject := corev1.Container{
-		Name:           containerName,
-		Image:          images.ResolveTag(tag, container.Image),
-		Command:        container.Entrypoint,
-		Args:           container.Command,
-		WorkingDir:     container.WorkingDir,
-		Env:            toEnv(app, container.Environment, app.Spec.Environment),
-		EnvFrom:        toEnvFrom(container.Environment),
-		TTY:            container.Interactive,
-		Stdin:          container.Interactive,
-		Ports:          toPorts(container),
-		VolumeMounts:   toMounts(app, deploymentName, containerName, container),
-		LivenessProbe:  toProbe(container, v1.LivenessProbeType),
-		StartupProbe:   toProbe(container, v1.StartupProbeType),
-		ReadinessProbe: toProbe(container, v1.ReadinessProbeType),
-		Resources:      app.Status.Scheduling[containerName].Requirements,
+		Name:            containerName,
+		Image:           images.ResolveTag(tag, container.Image),
+		Command:         container.Entrypoint,
+		Args:            container.Command,
+		WorkingDir:      container.WorkingDir,
+		Env:             toEnv(app, container.Environment, app.Spec.Environment),
+		EnvFrom:         toEnvFrom(container.Environment),
+		TTY:             container.Interactive,
+		Stdin:           container.Interactive,
+		Ports:           toPorts(container),
+		VolumeMounts:    toMounts(app, deploymentName, containerName, container),
+		LivenessProbe:   toProbe(container, v1.LivenessProbeType),
+		StartupProbe:    toProbe(container, v1.StartupProbeType),
+		ReadinessProbe:  toProbe(container, v1.ReadinessProbeType),
+		Resources:       app.Status.Scheduling[containerName].Requirements,
+		SecurityContext: podsecurity.ToSecurityContext(podSecurityProfile, container.SecurityContext),
+	}
+
+	if app.Spec.GetDevMode() && container.Dev != nil && container.Dev.Debug != nil {
+		if len(container.Dev.Debug.Command) > 0 {
+			containerObject.Command = container.Dev.Debug.Command
+			containerObject.Args = nil
+		}
+		// The container must not be restarted while the process is paused in the debugger
+		containerObject.LivenessProbe = nil
+		containerObject.StartupProbe = nil
 	}
 
 	return containerObject
@@ -580,12 +618,14 @@ func getSecretAnnotations(req router.Request, appInstance *v1.AppInstance, conta
 	return result, nil
 }
 
-func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Reference, name string, container v1.Container, pullSecrets *PullSecrets) (*appsv1.Deployment, error) {
+func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Reference, podSecurityProfile, name string, container v1.Container, pullSecrets *PullSecrets) (*appsv1.Deployment, error) {
 	var (
-		stateful = isStateful(appInstance, container)
+		// Stateful containers run as a StatefulSet, so each replica has its own copy of the volumes, and per node
+		// containers run as a DaemonSet
+		stateful = !container.Stateful && !container.PerNode && isStateful(appInstance, container)
 	)
 
-	containers, initContainers := toContainers(req, appInstance, tag, name, container)
+	containers, initContainers := toContainers(req, appInstance, tag, podSecurityProfile, name, container)
 
 	secretAnnotations, err := getSecretAnnotations(req, appInstance, container)
 	if err != nil {
@@ -624,6 +664,7 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 				Spec: corev1.PodSpec{
 					Affinity:                      appInstance.Status.Scheduling[name].Affinity,
 					Tolerations:                   appInstance.Status.Scheduling[name].Tolerations,
+					TopologySpreadConstraints:     appInstance.Status.Scheduling[name].TopologySpreadConstraints,
 					TerminationGracePeriodSeconds: &[]int64{5}[0],
 					ImagePullSecrets:              pullSecrets.ForContainer(name, append(containers, initContainers...)),
 					EnableServiceLinks:            new(bool),
@@ -652,11 +693,16 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 }
 
 func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullSecrets) (result []kclient.Object, _ error) {
+	cfg, err := config.GetForProject(req.Ctx, req.Client, appInstance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
 		if ports.IsLinked(appInstance, entry.Key) {
 			continue
 		}
-		dep, err := toDeployment(req, appInstance, tag, entry.Key, entry.Value, pullSecrets)
+		dep, err := toDeployment(req, appInstance, tag, podsecurity.Profile(cfg), entry.Key, entry.Value, pullSecrets)
 		if err != nil {
 			return nil, err
 		}
@@ -664,11 +710,130 @@ func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Ref
 		if perms := v1.FindPermission(dep.GetName(), appInstance.Spec.Permissions); perms.HasRules() {
 			result = append(result, toPermissions(perms, dep.GetLabels(), dep.GetAnnotations(), appInstance)...)
 		}
-		result = append(result, sa, dep, expose.ToPodDisruptionBudget(dep))
+		result = append(result, sa)
+		switch {
+		case entry.Value.PerNode:
+			// Disruption budgets only apply to pods of scalable workloads, which DaemonSets are not
+			result = append(result, toDaemonSet(appInstance, dep, entry.Value))
+		case entry.Value.Stateful:
+			sts, svc, err := toStatefulSet(req, appInstance, dep, entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, sts, svc, toPodDisruptionBudget(dep, entry.Value))
+		default:
+			result = append(result, dep, toPodDisruptionBudget(dep, entry.Value))
+		}
 	}
 	return result, nil
 }
 
+// toDaemonSet returns the DaemonSet that runs the pods of a per node container in place of the deployment. The pods
+// keep the affinity and tolerations of the compute class, so they only run on the nodes of the class.
+func toDaemonSet(appInstance *v1.AppInstance, dep *appsv1.Deployment, container v1.Container) *appsv1.DaemonSet {
+	template := *dep.Spec.Template.DeepCopy()
+	template.Spec.Hostname = ""
+
+	if appInstance.Spec.Stop != nil && *appInstance.Spec.Stop {
+		// DaemonSets can not be scaled down, so the pods of a stopped app are not scheduled to any node
+		template.Spec.NodeSelector = map[string]string{
+			stoppedNodeSelector: "true",
+		}
+	}
+
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: dep.ObjectMeta,
+		Spec: appsv1.DaemonSetSpec{
+			Selector: dep.Spec.Selector,
+			Template: template,
+		},
+	}
+
+	if container.MaxUnavailable != nil {
+		maxUnavailable := *container.MaxUnavailable
+		ds.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+			Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+	}
+
+	return ds
+}
+
+// stoppedNodeSelector is a node label that no node has
+const stoppedNodeSelector = labels.Prefix + "app-stopped"
+
+func headlessServiceName(name string) string {
+	return name2.SafeConcatName(name, "headless")
+}
+
+// toStatefulSet returns the StatefulSet that runs the pods of a stateful container in place of the deployment, and the
+// headless service that gives each replica a stable DNS name
+func toStatefulSet(req router.Request, appInstance *v1.AppInstance, dep *appsv1.Deployment, container v1.Container) (*appsv1.StatefulSet, *corev1.Service, error) {
+	claims, err := statefulSetClaims(req, appInstance, dep, container)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        headlessServiceName(dep.Name),
+			Namespace:   dep.Namespace,
+			Labels:      dep.Labels,
+			Annotations: dep.Annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  dep.Spec.Selector.MatchLabels,
+			// Replicas must be able to find each other before they are ready to form a cluster
+			PublishNotReadyAddresses: true,
+		},
+	}
+
+	template := *dep.Spec.Template.DeepCopy()
+	// The hostname of a StatefulSet pod is the pod name, which includes the ordinal of the replica
+	template.Spec.Hostname = ""
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: dep.ObjectMeta,
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:             dep.Spec.Replicas,
+			Selector:             dep.Spec.Selector,
+			Template:             template,
+			VolumeClaimTemplates: claims,
+			ServiceName:          svc.Name,
+			PodManagementPolicy:  appsv1.OrderedReadyPodManagement,
+		},
+	}
+
+	return sts, svc, nil
+}
+
+// statefulSetClaims returns the volume claim templates of the StatefulSet. The templates of a StatefulSet can't be
+// changed, so the templates of an existing StatefulSet are kept when labels, annotations or sizes of the volumes change.
+func statefulSetClaims(req router.Request, appInstance *v1.AppInstance, dep *appsv1.Deployment, container v1.Container) ([]corev1.PersistentVolumeClaim, error) {
+	var existing appsv1.StatefulSet
+	if err := req.Get(&existing, dep.Namespace, dep.Name); err == nil {
+		return existing.Spec.VolumeClaimTemplates, nil
+	} else if !apierror.IsNotFound(err) {
+		return nil, err
+	}
+	return toVolumeClaimTemplates(req, appInstance, dep.Name, container)
+}
+
+// toPodDisruptionBudget returns the disruption budget of the deployment, which uses the maxUnavailable of the container
+// if it runs more than one replica
+func toPodDisruptionBudget(dep *appsv1.Deployment, container v1.Container) *policyv1.PodDisruptionBudget {
+	pdb := expose.ToPodDisruptionBudget(dep)
+	if container.MaxUnavailable != nil && dep.Spec.Replicas != nil && *dep.Spec.Replicas > 1 {
+		maxUnavailable := *container.MaxUnavailable
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+	}
+	return pdb
+}
+
 func addFileContent(configMap *corev1.ConfigMap, appName, deploymentName string, container v1.Container) error {
 	data := configMap.BinaryData
 	for filePath, file := range container.Files {
@@ -9,13 +9,16 @@ import (
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/controller/namespace"
 	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/acorn/pkg/system"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/router/tester"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -188,6 +191,208 @@ func TestSidecar(t *testing.T) {
 	assert.Equal(t, "sidecar2", dep.Spec.Template.Spec.Containers[1].Image)
 }
 
+func TestDevSync(t *testing.T) {
+	ptrTrue := &[]bool{true}[0]
+	dep := ToDeploymentsTest(t, &v1.AppInstance{
+		Spec: v1.AppInstanceSpec{
+			DevMode: ptrTrue,
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"test": {
+						Image: "test",
+						Dev: &v1.Dev{
+							Sync: &v1.DevSync{
+								Paths:   map[string]string{"/app/src": "./src"},
+								Command: []string{"touch", "/app/reload"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, testTag, nil)[1].(*appsv1.Deployment)
+	assert.Equal(t, "acorn-helper", dep.Spec.Template.Spec.InitContainers[0].Name)
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      sanitizeVolumeName(AcornHelper),
+		MountPath: AcornHelperPath,
+	})
+	var volumes []string
+	for _, volume := range dep.Spec.Template.Spec.Volumes {
+		volumes = append(volumes, volume.Name)
+	}
+	assert.Contains(t, volumes, sanitizeVolumeName(AcornHelper))
+}
+
+func TestDevDebug(t *testing.T) {
+	ptrTrue := &[]bool{true}[0]
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"test": {
+						Image:      "test",
+						Entrypoint: []string{"/app/server"},
+						Command:    []string{"--listen", ":8080"},
+						Probes: v1.Probes{
+							{Type: v1.LivenessProbeType, TCP: &v1.TCPProbe{URL: "tcp://localhost:8080"}},
+							{Type: v1.ReadinessProbeType, TCP: &v1.TCPProbe{URL: "tcp://localhost:8080"}},
+						},
+						Dev: &v1.Dev{
+							Debug: &v1.DevDebug{
+								Port:    2345,
+								Command: []string{"dlv", "exec", "/app/server", "--headless", "--listen=:2345", "--", "--listen", ":8080"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// The debug command is only used in dev mode
+	con := ToDeploymentsTest(t, app, testTag, nil)[1].(*appsv1.Deployment).Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"/app/server"}, con.Command)
+	assert.Equal(t, []string{"--listen", ":8080"}, con.Args)
+	assert.NotNil(t, con.LivenessProbe)
+
+	app.Spec.DevMode = ptrTrue
+	con = ToDeploymentsTest(t, app, testTag, nil)[1].(*appsv1.Deployment).Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"dlv", "exec", "/app/server", "--headless", "--listen=:2345", "--", "--listen", ":8080"}, con.Command)
+	assert.Nil(t, con.Args)
+	assert.Nil(t, con.LivenessProbe)
+	assert.NotNil(t, con.ReadinessProbe)
+}
+
+func TestSecurityContext(t *testing.T) {
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"test": {
+						Image: "test",
+						SecurityContext: &v1.SecurityContext{
+							RunAsUser:              &[]int64{1000}[0],
+							ReadOnlyRootFilesystem: &[]bool{true}[0],
+						},
+						Sidecars: map[string]v1.Container{
+							"side": {
+								Image: "sidecar",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	spec := ToDeploymentsTest(t, app, testTag, nil)[1].(*appsv1.Deployment).Spec.Template.Spec
+	assert.Equal(t, &corev1.SecurityContext{
+		RunAsUser:              &[]int64{1000}[0],
+		ReadOnlyRootFilesystem: &[]bool{true}[0],
+	}, spec.Containers[0].SecurityContext)
+	assert.Nil(t, spec.Containers[1].SecurityContext)
+
+	// The restricted profile defaults the settings it requires for all containers
+	req := tester.NewRequest(t, scheme.Scheme, app, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.ConfigName,
+			Namespace: system.Namespace,
+		},
+		Data: map[string]string{
+			"config": `{"podSecurityEnforceProfile": "restricted"}`,
+		},
+	})
+	deps, err := ToDeployments(req, app, testTag, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec = deps[1].(*appsv1.Deployment).Spec.Template.Spec
+	for _, con := range spec.Containers {
+		assert.True(t, *con.SecurityContext.RunAsNonRoot)
+		assert.False(t, *con.SecurityContext.AllowPrivilegeEscalation)
+		assert.Equal(t, []corev1.Capability{"ALL"}, con.SecurityContext.Capabilities.Drop)
+		assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, con.SecurityContext.SeccompProfile.Type)
+	}
+	assert.True(t, *spec.Containers[0].SecurityContext.ReadOnlyRootFilesystem)
+}
+
+func TestPodDisruptionBudget(t *testing.T) {
+	maxUnavailable := intstr.FromString("50%")
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"test": {
+						Image:          "test",
+						Scale:          &[]int32{4}[0],
+						MaxUnavailable: &maxUnavailable,
+					},
+				},
+			},
+			Scheduling: map[string]v1.Scheduling{
+				"test": {
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+					},
+				},
+			},
+		},
+	}
+
+	objs := ToDeploymentsTest(t, app, testTag, nil)
+	assert.Equal(t, app.Status.Scheduling["test"].TopologySpreadConstraints, objs[1].(*appsv1.Deployment).Spec.Template.Spec.TopologySpreadConstraints)
+	assert.Equal(t, "50%", objs[2].(*policyv1.PodDisruptionBudget).Spec.MaxUnavailable.String())
+
+	// The maxUnavailable of the container is ignored when it runs a single replica
+	app.Status.AppSpec.Containers["test"] = v1.Container{
+		Image:          "test",
+		MaxUnavailable: &maxUnavailable,
+	}
+	objs = ToDeploymentsTest(t, app, testTag, nil)
+	assert.Equal(t, "25%", objs[2].(*policyv1.PodDisruptionBudget).Spec.MaxUnavailable.String())
+}
+
+func TestPerNode(t *testing.T) {
+	maxUnavailable := intstr.FromInt(2)
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"agent": {
+						Image:          "agent",
+						PerNode:        true,
+						MaxUnavailable: &maxUnavailable,
+					},
+				},
+			},
+			Scheduling: map[string]v1.Scheduling{
+				"agent": {
+					Tolerations: []corev1.Toleration{
+						{Key: "dedicated", Operator: corev1.TolerationOpExists},
+					},
+				},
+			},
+		},
+	}
+
+	objs := ToDeploymentsTest(t, app, testTag, nil)
+	// The service account and the DaemonSet, without a disruption budget
+	assert.Len(t, objs, 2)
+	ds := objs[1].(*appsv1.DaemonSet)
+	assert.Equal(t, "agent", ds.Name)
+	assert.Equal(t, app.Status.Scheduling["agent"].Tolerations, ds.Spec.Template.Spec.Tolerations)
+	assert.Empty(t, ds.Spec.Template.Spec.Hostname)
+	assert.Empty(t, ds.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, "2", ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.String())
+
+	// The pods of a stopped app are not scheduled to any node
+	app.Spec.Stop = &[]bool{true}[0]
+	ds = ToDeploymentsTest(t, app, testTag, nil)[1].(*appsv1.DaemonSet)
+	assert.Equal(t, map[string]string{stoppedNodeSelector: "true"}, ds.Spec.Template.Spec.NodeSelector)
+}
+
 func TestPorts(t *testing.T) {
 	dep := ToDeploymentsTest(t, &v1.AppInstance{
 		Status: v1.AppInstanceStatus{
@@ -0,0 +1,108 @@
+package appdefinition
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/condition"
+	"github.com/acorn-io/baaah/pkg/router"
+)
+
+const domainChallengePrefix = "_acorn-challenge."
+
+var (
+	// lookupTXT is a var so that tests can stub out DNS
+	lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		return net.DefaultResolver.LookupTXT(ctx, name)
+	}
+	domainRecheckInterval = time.Minute
+)
+
+// domainToken returns the value of the TXT record that proves the ownership of the domain for the app
+func domainToken(app *v1.AppInstance, domain string) string {
+	sum := sha256.Sum256([]byte(string(app.UID) + "/" + domain))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// VerifyDomains verifies the ownership of the custom domains of the app by looking up the TXT record
+// _acorn-challenge.<domain>. A domain stays verified once the record has been found, so that the record can be removed.
+// Domains that are not verified yet are checked again every minute.
+func VerifyDomains(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if len(app.Spec.Domains) == 0 && len(app.Status.Domains) == 0 {
+		return nil
+	}
+	cond := condition.Setter(app, resp, v1.AppInstanceConditionDomains)
+
+	existing := map[string]v1.DomainStatus{}
+	for _, status := range app.Status.Domains {
+		existing[status.Domain] = status
+	}
+
+	var (
+		result  []v1.DomainStatus
+		pending []string
+		seen    = map[string]bool{}
+	)
+	for _, binding := range app.Spec.Domains {
+		if seen[binding.Domain] {
+			continue
+		}
+		seen[binding.Domain] = true
+
+		status := v1.DomainStatus{
+			Domain: binding.Domain,
+			Record: domainChallengePrefix + binding.Domain,
+			Token:  domainToken(app, binding.Domain),
+		}
+		if old, ok := existing[binding.Domain]; ok && old.Verified && old.Token == status.Token {
+			status.Verified = true
+			result = append(result, status)
+			continue
+		}
+
+		records, err := lookupTXT(req.Ctx, status.Record)
+		if err != nil {
+			status.Message = fmt.Sprintf("failed to look up TXT record %s: %v", status.Record, err)
+		} else if !containsToken(records, status.Token) {
+			status.Message = fmt.Sprintf("waiting for TXT record %s with value %s", status.Record, status.Token)
+		} else {
+			status.Verified = true
+		}
+		if !status.Verified {
+			pending = append(pending, status.Domain)
+		}
+		result = append(result, status)
+	}
+
+	app.Status.Domains = result
+	if len(pending) == 0 {
+		cond.Success()
+		return nil
+	}
+
+	sort.Strings(pending)
+	// Unverified domains are not served, but they do not keep the app from being ready
+	cond.Set(v1.Condition{
+		Success: true,
+		Message: fmt.Sprintf("waiting for ownership of domains to be verified: %s", strings.Join(pending, ", ")),
+	})
+	resp.RetryAfter(domainRecheckInterval)
+	return nil
+}
+
+func containsToken(records []string, token string) bool {
+	for _, record := range records {
+		if strings.TrimSpace(record) == token {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package appdefinition
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVerifyDomains(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn", UID: "1234567890abcdef"},
+		Spec: v1.AppInstanceSpec{
+			Domains: []v1.DomainBinding{
+				{Domain: "api.example.com", Target: "web"},
+				{Domain: "www.example.com", Target: "web"},
+			},
+		},
+	}
+
+	records := map[string][]string{
+		"_acorn-challenge.api.example.com": {domainToken(app, "api.example.com")},
+		"_acorn-challenge.www.example.com": {"something else"},
+	}
+	oldLookupTXT := lookupTXT
+	defer func() {
+		lookupTXT = oldLookupTXT
+	}()
+	lookupTXT = func(_ context.Context, name string) ([]string, error) {
+		return records[name], nil
+	}
+
+	req := tester.NewRequest(t, scheme.Scheme, app)
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, VerifyDomains(req, resp))
+
+	require.Len(t, app.Status.Domains, 2)
+	assert.True(t, app.Status.Domains[0].Verified)
+	assert.False(t, app.Status.Domains[1].Verified)
+	assert.Equal(t, "_acorn-challenge.www.example.com", app.Status.Domains[1].Record)
+	assert.Contains(t, app.Status.Domains[1].Message, app.Status.Domains[1].Token)
+	assert.Equal(t, domainRecheckInterval, resp.Delay)
+
+	cond := app.Status.Condition(v1.AppInstanceConditionDomains)
+	assert.True(t, cond.Success)
+	assert.Equal(t, "waiting for ownership of domains to be verified: www.example.com", cond.Message)
+
+	// Verified domains stay verified when the record is removed
+	records = map[string][]string{
+		"_acorn-challenge.www.example.com": {domainToken(app, "www.example.com")},
+	}
+	resp = &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, VerifyDomains(req, resp))
+	assert.True(t, app.Status.Domains[0].Verified)
+	assert.True(t, app.Status.Domains[1].Verified)
+	assert.Zero(t, resp.Delay)
+	assert.Empty(t, app.Status.Condition(v1.AppInstanceConditionDomains).Message)
+}
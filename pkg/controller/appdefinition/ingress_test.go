@@ -89,3 +89,7 @@ func TestIngressLabelsNamespace(t *testing.T) {
 func TestLetsEncrypt(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/letsencrypt", DeploySpec)
 }
+
+func TestIngressCustomDomains(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/domains", DeploySpec)
+}
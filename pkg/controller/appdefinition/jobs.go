@@ -4,7 +4,9 @@ import (
 	"strings"
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
 	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/podsecurity"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -24,8 +26,13 @@ func addJobs(req router.Request, appInstance *v1.AppInstance, tag name.Reference
 }
 
 func toJobs(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSecrets, tag name.Reference) (result []kclient.Object, _ error) {
+	cfg, err := config.GetForProject(req.Ctx, req.Client, appInstance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Jobs) {
-		job, err := toJob(req, appInstance, pullSecrets, tag, entry.Key, entry.Value)
+		job, err := toJob(req, appInstance, pullSecrets, tag, podsecurity.Profile(cfg), entry.Key, entry.Value)
 		if err != nil {
 			return nil, err
 		}
@@ -46,8 +53,8 @@ func setTerminationPath(containers []corev1.Container) (result []corev1.Containe
 	return
 }
 
-func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSecrets, tag name.Reference, name string, container v1.Container) (kclient.Object, error) {
-	containers, initContainers := toContainers(req, appInstance, tag, name, container)
+func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSecrets, tag name.Reference, podSecurityProfile, name string, container v1.Container) (kclient.Object, error) {
+	containers, initContainers := toContainers(req, appInstance, tag, podSecurityProfile, name, container)
 
 	secretAnnotations, err := getSecretAnnotations(req, appInstance, container)
 	if err != nil {
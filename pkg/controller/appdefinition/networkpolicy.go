@@ -0,0 +1,369 @@
+package appdefinition
+
+import (
+	"sort"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/ports"
+	"github.com/acorn-io/acorn/pkg/system"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	isolationPolicyName = "acorn-isolation"
+	publishPolicyPrefix = "acorn-publish"
+	egressPolicyPrefix  = "acorn-egress"
+)
+
+// NetworkPolicies isolates the workloads of the app when network policies are enabled. Traffic to the workloads is
+// only allowed from the app namespace, the Acorn routers of the app, the namespaces of the apps that link to the app,
+// and from the ingress controller to published ports. Traffic from the workloads that declare egress rules is only
+// allowed to the declared destinations, DNS, and the apps of the project.
+func NetworkPolicies(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	app.Status.NetworkPolicies = *cfg.NetworkPolicies
+	resp.Objects(app)
+	if !app.Status.NetworkPolicies {
+		return nil
+	}
+
+	projectCfg, err := config.GetForProject(req.Ctx, req.Client, app.Namespace)
+	if err != nil {
+		return err
+	}
+
+	linked, err := linkingNamespaces(req, app)
+	if err != nil {
+		return err
+	}
+
+	policies, err := toNetworkPolicies(cfg, projectCfg.DefaultPublishMode, app, linked)
+	if err != nil {
+		return err
+	}
+
+	resp.Objects(policies...)
+	resp.Objects(toEgressNetworkPolicies(app)...)
+	return nil
+}
+
+// linkingNamespaces returns the namespaces of the apps in the same project that link to a service of the app, and of
+// the nested apps that link to a service of their parent app
+func linkingNamespaces(req router.Request, app *v1.AppInstance) ([]string, error) {
+	result := sets.NewString()
+
+	projectApps := &v1.AppInstanceList{}
+	if err := req.List(projectApps, &kclient.ListOptions{Namespace: app.Namespace}); err != nil {
+		return nil, err
+	}
+	for _, other := range projectApps.Items {
+		if other.Name == app.Name || other.Status.Namespace == "" {
+			continue
+		}
+		for _, link := range other.Spec.Links {
+			svc := &corev1.Service{}
+			if err := req.Get(svc, app.Namespace, link.Service); apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			if svc.Labels[labels.AcornAppName] == app.Name {
+				result.Insert(other.Status.Namespace)
+			}
+		}
+	}
+
+	nestedApps := &v1.AppInstanceList{}
+	if err := req.List(nestedApps, &kclient.ListOptions{Namespace: app.Status.Namespace}); err != nil {
+		return nil, err
+	}
+	for _, nested := range nestedApps.Items {
+		if len(nested.Spec.Links) > 0 && nested.Status.Namespace != "" {
+			result.Insert(nested.Status.Namespace)
+		}
+	}
+
+	return result.List(), nil
+}
+
+func toNetworkPolicies(cfg *apiv1.Config, defaultPublishMode v1.PublishMode, app *v1.AppInstance, linkingNamespaces []string) (result []kclient.Object, _ error) {
+	peers := []networkingv1.NetworkPolicyPeer{
+		{
+			// Traffic between the workloads of the app
+			PodSelector: &metav1.LabelSelector{},
+		},
+		{
+			// The routers of the services that the app exposes to other apps
+			NamespaceSelector: namespaceSelector(system.Namespace),
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: labels.Managed(app),
+			},
+		},
+	}
+	if len(linkingNamespaces) > 0 {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: namespaceSelector(linkingNamespaces...),
+		})
+	}
+
+	result = append(result, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      isolationPolicyName,
+			Namespace: app.Status.Namespace,
+			Labels:    labels.Managed(app),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: labels.Managed(app),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: peers,
+				},
+			},
+		},
+	})
+
+	if app.Spec.PublishMode == "" {
+		app = app.DeepCopy()
+		app.Spec.PublishMode = defaultPublishMode
+	}
+
+	// HTTP ports are published through the ingress controller, other ports through load balancers that can be reached
+	// from anywhere
+	httpPorts := map[string][]v1.PortDef{}
+	for _, publish := range []func(*v1.AppInstance) (*ports.Set, error){
+		ports.NewForIngressPublish,
+		ports.NewForRouterPublish,
+	} {
+		set, err := publish(app)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range set.ServiceNames() {
+			httpPorts[service] = append(httpPorts[service], set.PortsForService(service)...)
+		}
+	}
+
+	lbSet, err := ports.NewForServiceLBPublish(app)
+	if err != nil {
+		return nil, err
+	}
+	lbPorts := map[string][]v1.PortDef{}
+	for _, service := range lbSet.ServiceNames() {
+		lbPorts[service] = lbSet.PortsForService(service)
+	}
+
+	var ingressPeers []networkingv1.NetworkPolicyPeer
+	if *cfg.IngressControllerNamespace != "" {
+		ingressPeers = append(ingressPeers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: namespaceSelector(*cfg.IngressControllerNamespace),
+		})
+	}
+
+	for _, service := range sets.StringKeySet(httpPorts).Union(sets.StringKeySet(lbPorts)).List() {
+		var rules []networkingv1.NetworkPolicyIngressRule
+		if len(httpPorts[service]) > 0 {
+			rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+				Ports: toNetworkPolicyPorts(httpPorts[service]),
+				From:  ingressPeers,
+			})
+		}
+		if len(lbPorts[service]) > 0 {
+			rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+				Ports: toNetworkPolicyPorts(lbPorts[service]),
+			})
+		}
+
+		result = append(result, &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name2.SafeConcatName(publishPolicyPrefix, service),
+				Namespace: app.Status.Namespace,
+				Labels:    labels.Managed(app),
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: labels.Managed(app, labels.AcornServiceNamePrefix+service, "true"),
+				},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress:     rules,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+func toEgressNetworkPolicies(app *v1.AppInstance) (result []kclient.Object) {
+	for _, entry := range typed.Sorted(app.Status.AppSpec.Containers) {
+		if policy := toEgressNetworkPolicy(app, labels.AcornContainerName, entry.Key, entry.Value); policy != nil {
+			result = append(result, policy)
+		}
+	}
+	for _, entry := range typed.Sorted(app.Status.AppSpec.Jobs) {
+		if policy := toEgressNetworkPolicy(app, labels.AcornJobName, entry.Key, entry.Value); policy != nil {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+// toEgressNetworkPolicy returns the policy restricting the traffic from the pods of the container or job, or nil if
+// neither the app nor the container and its sidecars declare egress rules
+func toEgressNetworkPolicy(app *v1.AppInstance, nameLabel, name string, container v1.Container) kclient.Object {
+	egress := append(append([]v1.EgressRule{}, app.Status.AppSpec.Egress...), container.Egress...)
+	for _, sidecar := range typed.Sorted(container.Sidecars) {
+		egress = append(egress, sidecar.Value.Egress...)
+	}
+	if len(egress) == 0 {
+		return nil
+	}
+
+	udp, tcp := corev1.ProtocolUDP, corev1.ProtocolTCP
+	dns := intstr.FromInt(53)
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &dns},
+				{Protocol: &tcp, Port: &dns},
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					// Traffic between the workloads of the app
+					PodSelector: &metav1.LabelSelector{},
+				},
+				{
+					// The Acorn routers
+					NamespaceSelector: namespaceSelector(system.Namespace),
+				},
+				{
+					// The apps of the project that the app links to
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							labels.AcornAppNamespace: app.Namespace,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, rule := range egress {
+		ports := toEgressNetworkPolicyPorts(rule)
+		if len(rule.CIDRs) > 0 {
+			var to []networkingv1.NetworkPolicyPeer
+			for _, cidr := range rule.CIDRs {
+				to = append(to, networkingv1.NetworkPolicyPeer{
+					IPBlock: &networkingv1.IPBlock{
+						CIDR: cidr,
+					},
+				})
+			}
+			rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+				Ports: ports,
+				To:    to,
+			})
+		}
+		// Network policies can't match host names, so rules with hosts or without destinations only restrict the ports
+		if len(rule.Hosts) > 0 || len(rule.CIDRs) == 0 {
+			rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+				Ports: ports,
+			})
+		}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name2.SafeConcatName(egressPolicyPrefix, name),
+			Namespace: app.Status.Namespace,
+			Labels:    labels.Managed(app),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: labels.Managed(app, nameLabel, name),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      rules,
+		},
+	}
+}
+
+func toEgressNetworkPolicyPorts(rule v1.EgressRule) (result []networkingv1.NetworkPolicyPort) {
+	protocol := corev1.ProtocolTCP
+	if rule.Protocol != "" {
+		protocol = corev1.Protocol(strings.ToUpper(string(ports.NormalizeProto(rule.Protocol))))
+	}
+	for _, port := range rule.Ports {
+		port := intstr.FromInt(int(port))
+		result = append(result, networkingv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			Port:     &port,
+		})
+	}
+	return result
+}
+
+func namespaceSelector(namespaces ...string) *metav1.LabelSelector {
+	if len(namespaces) == 1 {
+		return &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				corev1.LabelMetadataName: namespaces[0],
+			},
+		}
+	}
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   namespaces,
+			},
+		},
+	}
+}
+
+func toNetworkPolicyPorts(portDefs []v1.PortDef) (result []networkingv1.NetworkPolicyPort) {
+	seen := map[string]bool{}
+	for _, port := range portDefs {
+		protocol := corev1.Protocol(strings.ToUpper(string(ports.NormalizeProto(port.Protocol))))
+		targetPort := intstr.FromInt(int(port.TargetPort))
+		key := string(protocol) + "/" + targetPort.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, networkingv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			Port:     &targetPort,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Port.IntVal == result[j].Port.IntVal {
+			return *result[i].Protocol < *result[j].Protocol
+		}
+		return result[i].Port.IntVal < result[j].Port.IntVal
+	})
+	return result
+}
@@ -0,0 +1,23 @@
+package appdefinition
+
+import (
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPolicies(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy", NetworkPolicies)
+}
+
+func TestNetworkPoliciesDisabled(t *testing.T) {
+	resp := tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy-disabled", NetworkPolicies)
+	// Only the app is returned so that existing policies are removed
+	assert.Len(t, resp.Collected, 1)
+}
+
+func TestNetworkPoliciesEgress(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy-egress", NetworkPolicies)
+}
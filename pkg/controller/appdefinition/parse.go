@@ -16,25 +16,28 @@ func ParseAppImage(req router.Request, resp router.Response) error {
 		return nil
 	}
 
-	appDef, err := appdefinition.FromAppImage(&appImage)
+	appSpec, err := parseAppSpec(appInstance, appImage)
 	if err != nil {
 		status.Error(err)
 		return nil
 	}
 
-	appDef, _, err = appDef.WithArgs(appInstance.Spec.DeployArgs, appInstance.Spec.GetProfiles())
+	appInstance.Status.AppSpec = *appSpec
+	status.Success()
+	return nil
+}
+
+// parseAppSpec returns the app spec of the image with the deploy args and profiles of the app
+func parseAppSpec(appInstance *v1.AppInstance, appImage v1.AppImage) (*v1.AppSpec, error) {
+	appDef, err := appdefinition.FromAppImage(&appImage)
 	if err != nil {
-		status.Error(err)
-		return nil
+		return nil, err
 	}
 
-	appSpec, err := appDef.AppSpec()
+	appDef, _, err = appDef.WithArgs(appInstance.Spec.DeployArgs, appInstance.Spec.GetProfiles())
 	if err != nil {
-		status.Error(err)
-		return nil
+		return nil, err
 	}
 
-	appInstance.Status.AppSpec = *appSpec
-	status.Success()
-	return nil
+	return appDef.AppSpec()
 }
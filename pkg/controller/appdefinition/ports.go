@@ -2,12 +2,22 @@ package appdefinition
 
 import (
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
 	"github.com/acorn-io/acorn/pkg/expose"
 	"github.com/acorn-io/acorn/pkg/publish"
 	"github.com/acorn-io/baaah/pkg/router"
 )
 
 func addPublish(req router.Request, app *v1.AppInstance, resp router.Response) error {
+	cfg, err := config.GetForProject(req.Ctx, req.Client, app.Namespace)
+	if err != nil {
+		return err
+	}
+	if app.Spec.PublishMode == "" {
+		app = app.DeepCopy()
+		app.Spec.PublishMode = cfg.DefaultPublishMode
+	}
+
 	objs, err := publish.Containers(app)
 	if err != nil {
 		return err
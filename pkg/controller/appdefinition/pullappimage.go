@@ -1,18 +1,27 @@
 package appdefinition
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/autoupgrade"
 	"github.com/acorn-io/acorn/pkg/condition"
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
 	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagescan"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
 	"github.com/acorn-io/acorn/pkg/tags"
+	"github.com/acorn-io/acorn/pkg/upgradewebhook"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const scanRecheckInterval = 15 * time.Second
+
 func PullAppImage(transport http.RoundTripper) router.HandlerFunc {
 	return func(req router.Request, resp router.Response) error {
 		appInstance := req.Object.(*v1.AppInstance)
@@ -34,12 +43,47 @@ func PullAppImage(transport http.RoundTripper) router.HandlerFunc {
 			return nil
 		}
 
+		// Patterns and tags can resolve to an image that was never checked at admission, so check the resolved
+		// image before it is pulled
+		if err := imagepolicy.CheckImageAllowed(req.Ctx, req.Client, appInstance.Namespace, resolvedImage); err != nil {
+			cond.Error(fmt.Errorf("%s: %w", targetImage, err))
+			return nil
+		}
+
 		appImage, err := images.PullAppImage(req.Ctx, req.Client, appInstance.Namespace, resolvedImage, remote.WithTransport(transport))
 		if err != nil {
 			cond.Error(err)
 			return nil
 		}
+
+		if err := imagesignature.VerifyImage(req.Ctx, req.Client, appInstance.Namespace, resolvedImage, appImage.Digest, remote.WithTransport(transport)); err != nil {
+			cond.Error(fmt.Errorf("%s: %w", targetImage, err))
+			return nil
+		}
+
+		if err := imagescan.CheckImage(req.Ctx, req.Client, appInstance.Namespace, resolvedImage, appImage.Digest, remote.WithTransport(transport)); errors.Is(err, imagescan.ErrScanPending) {
+			cond.Unknown(fmt.Sprintf("%s: %v", targetImage, err))
+			resp.RetryAfter(scanRecheckInterval)
+			return nil
+		} else if err != nil {
+			cond.Error(fmt.Errorf("%s: %w", targetImage, err))
+			return nil
+		}
+
+		if err := checkQuota(req, appInstance, *appImage); err != nil {
+			cond.Error(fmt.Errorf("%s: %w", targetImage, err))
+			return nil
+		}
+
 		appImage.Name = targetImage
+		fromUpgrade := appInstance.Status.AvailableAppImage == targetImage
+		if fromUpgrade && appInstance.Status.AppImage.ID != "" && appInstance.Status.AppImage.ID != appImage.ID {
+			// Deliveries are retried, so don't hold up the handler while they are sent. The handler runs again for the
+			// same upgrade if the status update conflicts, so only the first run notifies.
+			go upgradewebhook.NotifyOnce(req.Ctx, req.Client, string(appInstance.UID)+"/"+appImage.ID,
+				upgradewebhook.NewEvent(v1.UpgradeEventApplied, appInstance, targetImage, appImage.Digest))
+		}
+		recordUpgrade(appInstance, *appImage, fromUpgrade, time.Now())
 		appInstance.Status.AvailableAppImage = ""
 		appInstance.Status.ConfirmUpgradeAppImage = ""
 		appInstance.Status.AppImage = *appImage
@@ -49,6 +93,27 @@ func PullAppImage(transport http.RoundTripper) router.HandlerFunc {
 	}
 }
 
+// recordUpgrade records an upgrade of the app to the new image in its upgrade history and starts the soak period of
+// its upgrade policy, so the previous image can be restored if the app doesn't stay ready
+func recordUpgrade(appInstance *v1.AppInstance, appImage v1.AppImage, fromUpgrade bool, now time.Time) {
+	previous := appInstance.Status.AppImage
+	if !fromUpgrade || appInstance.Spec.UpgradePolicy == nil || previous.ID == "" || previous.ID == appImage.ID {
+		if !fromUpgrade {
+			// The image was changed by the user, so there is nothing to revert to
+			appInstance.Status.UpgradeSoak = nil
+		}
+		return
+	}
+
+	autoupgrade.RecordDecision(&appInstance.Status, v1.UpgradeDecisionApplied, appImage.Name, appImage.Digest, "", now)
+	if soak, err := autoupgrade.SoakPeriod(appInstance.Spec.UpgradePolicy); err == nil && soak > 0 {
+		appInstance.Status.UpgradeSoak = &v1.UpgradeSoak{
+			PreviousAppImage: previous,
+			Started:          metav1.NewTime(now),
+		}
+	}
+}
+
 func determineTargetImage(appInstance *v1.AppInstance) (string, string) {
 	_, on := autoupgrade.Mode(appInstance.Spec)
 	pattern, isPattern := autoupgrade.AutoUpgradePattern(appInstance.Spec.Image)
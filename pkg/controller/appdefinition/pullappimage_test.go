@@ -2,6 +2,7 @@ package appdefinition
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +72,31 @@ func app(specImage, statusImageID, statusAvailableImage, statusConfirmUpgradeIma
 		},
 	}
 }
+
+func TestRecordUpgrade(t *testing.T) {
+	now := time.Now()
+	newImage := v1.AppImage{ID: "2222", Name: "acorn.io/img:2", Digest: "sha256:2222"}
+
+	// Upgrade with a soak period records the decision and starts the soak
+	appInstance := app("acorn.io/img:#", "acorn.io/img:1", "acorn.io/img:2", "", false, false)
+	appInstance.Status.AppImage.ID = "1111"
+	appInstance.Spec.UpgradePolicy = &v1.UpgradePolicy{SoakPeriod: "10m"}
+	recordUpgrade(appInstance, newImage, true, now)
+	assert.Len(t, appInstance.Status.UpgradeHistory, 1)
+	assert.Equal(t, v1.UpgradeDecisionApplied, appInstance.Status.UpgradeHistory[0].Decision)
+	if assert.NotNil(t, appInstance.Status.UpgradeSoak) {
+		assert.Equal(t, "1111", appInstance.Status.UpgradeSoak.PreviousAppImage.ID)
+	}
+
+	// An image change by the user clears the soak
+	recordUpgrade(appInstance, newImage, false, now)
+	assert.Nil(t, appInstance.Status.UpgradeSoak)
+	assert.Len(t, appInstance.Status.UpgradeHistory, 1)
+
+	// Without an upgrade policy nothing is recorded
+	appInstance = app("acorn.io/img:#", "acorn.io/img:1", "acorn.io/img:2", "", false, false)
+	appInstance.Status.AppImage.ID = "1111"
+	recordUpgrade(appInstance, newImage, true, now)
+	assert.Nil(t, appInstance.Status.UpgradeSoak)
+	assert.Empty(t, appInstance.Status.UpgradeHistory)
+}
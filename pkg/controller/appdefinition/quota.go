@@ -0,0 +1,42 @@
+package appdefinition
+
+import (
+	"errors"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/controller/defaults"
+	"github.com/acorn-io/acorn/pkg/controller/scheduling"
+	"github.com/acorn-io/acorn/pkg/quota"
+	"github.com/acorn-io/baaah/pkg/router"
+)
+
+// checkQuota returns an error if running the image takes the project of the app over its quota. The images of apps
+// with an auto-upgrade pattern, and the images they are upgraded to, are only known once they are pulled, so the quota
+// checked when the app is created or updated does not cover them.
+func checkQuota(req router.Request, appInstance *v1.AppInstance, appImage v1.AppImage) error {
+	projectQuota, err := quota.ForProject(req.Ctx, req.Client, appInstance.Namespace)
+	if err != nil || projectQuota == nil || appImage.Acornfile == "" {
+		return err
+	}
+
+	app := appInstance.DeepCopy()
+	appSpec, err := parseAppSpec(app, appImage)
+	if err != nil {
+		return err
+	}
+	app.Status.AppImage = appImage
+	app.Status.AppSpec = *appSpec
+	if err := defaults.ForApp(req, app); err != nil {
+		return err
+	}
+	if err := scheduling.ForApp(req, app); err != nil {
+		return err
+	}
+
+	exceeded, err := quota.Check(req.Ctx, req.Client, req.Client, projectQuota, app)
+	if err != nil || len(exceeded) == 0 {
+		return err
+	}
+	return errors.New(strings.Join(exceeded, ", "))
+}
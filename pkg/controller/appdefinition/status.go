@@ -231,7 +231,8 @@ func VolumeStatus(req router.Request, resp router.Response) error {
 	})
 
 	for _, pvc := range pvcs.Items {
-		if pvc.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(app.Generation)) {
+		// Claims of stateful containers are created by the StatefulSet and are not updated with the app
+		if pvc.Labels[labels.AcornContainerName] == "" && pvc.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(app.Generation)) {
 			messages = append(messages, fmt.Sprintf("volume %s is not ready", pvc.Name))
 		}
 
@@ -298,9 +299,11 @@ func podsStatus(req router.Request, namespace string, sel klabels.Selector) (boo
 
 func AppStatus(req router.Request, resp router.Response) error {
 	var (
-		app  = req.Object.(*v1.AppInstance)
-		cond = condition.Setter(app, resp, v1.AppInstanceConditionContainers)
-		deps = &appsv1.DeploymentList{}
+		app          = req.Object.(*v1.AppInstance)
+		cond         = condition.Setter(app, resp, v1.AppInstanceConditionContainers)
+		deps         = &appsv1.DeploymentList{}
+		statefulSets = &appsv1.StatefulSetList{}
+		daemonSets   = &appsv1.DaemonSetList{}
 	)
 
 	cfg, err := config.Get(req.Ctx, req.Client)
@@ -308,14 +311,20 @@ func AppStatus(req router.Request, resp router.Response) error {
 		return err
 	}
 
-	err = req.List(deps, &kclient.ListOptions{
+	listOpts := &kclient.ListOptions{
 		Namespace: app.Status.Namespace,
 		LabelSelector: klabels.SelectorFromSet(map[string]string{
 			labels.AcornManaged: "true",
 			labels.AcornAppName: app.Name,
 		}),
-	})
-	if err != nil {
+	}
+	if err = req.List(deps, listOpts); err != nil {
+		return err
+	}
+	if err = req.List(statefulSets, listOpts); err != nil {
+		return err
+	}
+	if err = req.List(daemonSets, listOpts); err != nil {
 		return err
 	}
 
@@ -341,22 +350,22 @@ func AppStatus(req router.Request, resp router.Response) error {
 		}
 	}
 
-	for _, dep := range deps.Items {
-		containerName := dep.Labels[labels.AcornContainerName]
+	addStatus := func(obj metav1.ObjectMeta, ready, replicas, updated int32) {
+		containerName := obj.Labels[labels.AcornContainerName]
 		if containerName == "" {
-			continue
+			return
 		}
 
 		status := container[containerName]
-		status.Ready = dep.Status.ReadyReplicas
-		status.ReadyDesired = dep.Status.Replicas
-		status.UpToDate = dep.Status.UpdatedReplicas
+		status.Ready = ready
+		status.ReadyDesired = replicas
+		status.UpToDate = updated
 		status.Created = true
 		container[containerName] = status
 
 		if podMessage := podMessages[containerName]; len(podMessage) > 0 {
 			messages = append(messages, podMessage...)
-		} else if dep.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(app.Generation)) {
+		} else if obj.Annotations[labels.AcornAppGeneration] != strconv.Itoa(int(app.Generation)) {
 			isTransition = true
 			messages = append(messages, containerName+" pending update")
 		} else if status.Ready != status.ReadyDesired {
@@ -365,6 +374,16 @@ func AppStatus(req router.Request, resp router.Response) error {
 		}
 	}
 
+	for _, dep := range deps.Items {
+		addStatus(dep.ObjectMeta, dep.Status.ReadyReplicas, dep.Status.Replicas, dep.Status.UpdatedReplicas)
+	}
+	for _, sts := range statefulSets.Items {
+		addStatus(sts.ObjectMeta, sts.Status.ReadyReplicas, sts.Status.Replicas, sts.Status.UpdatedReplicas)
+	}
+	for _, ds := range daemonSets.Items {
+		addStatus(ds.ObjectMeta, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled, ds.Status.UpdatedNumberScheduled)
+	}
+
 	for name, status := range container {
 		if !status.Created {
 			messages = append(messages, name+" pending create")
@@ -0,0 +1,14 @@
+package appdefinition
+
+import (
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/autoupgrade"
+	"github.com/acorn-io/baaah/pkg/router"
+)
+
+// UpgradeCheckRequested tells the auto-upgrade daemon to check the app immediately when a registry push notification
+// requested it
+func UpgradeCheckRequested(req router.Request, _ router.Response) error {
+	autoupgrade.RequestCheck(req.Object.(*v1.AppInstance))
+	return nil
+}
@@ -0,0 +1,72 @@
+package appdefinition
+
+import (
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/autoupgrade"
+	"github.com/acorn-io/baaah/pkg/router"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradeSoak keeps an automatic upgrade if the app stays ready for the soak period of its upgrade policy. If the app
+// doesn't become ready during the soak period or stops being ready, the previous image is restored.
+func UpgradeSoak(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	soak := app.Status.UpgradeSoak
+	if soak == nil {
+		return nil
+	}
+
+	period, err := autoupgrade.SoakPeriod(app.Spec.UpgradePolicy)
+	if err != nil || period == 0 {
+		// The soak period was removed from the policy, so keep the upgrade
+		app.Status.UpgradeSoak = nil
+		return err
+	}
+
+	now := time.Now()
+	if !upgradeReady(app) {
+		if soak.ReadySince != nil {
+			revertUpgrade(app, "app stopped being ready during the soak period", now)
+		} else if elapsed := now.Sub(soak.Started.Time); elapsed >= period {
+			revertUpgrade(app, "app did not become ready within the soak period", now)
+		} else {
+			resp.RetryAfter(period - elapsed)
+		}
+		return nil
+	}
+
+	if soak.ReadySince == nil {
+		soak.ReadySince = &metav1.Time{Time: now}
+	}
+	if elapsed := now.Sub(soak.ReadySince.Time); elapsed < period {
+		resp.RetryAfter(period - elapsed)
+		return nil
+	}
+
+	autoupgrade.RecordDecision(&app.Status, v1.UpgradeDecisionSucceeded, app.Status.AppImage.Name, app.Status.AppImage.Digest,
+		"app stayed ready for the soak period", now)
+	app.Status.UpgradeSoak = nil
+	return nil
+}
+
+// upgradeReady returns true if the app is ready and all of its containers are running the upgraded version
+func upgradeReady(app *v1.AppInstance) bool {
+	if !app.Status.Ready {
+		return false
+	}
+	for _, status := range app.Status.ContainerStatus {
+		if status.UpToDate < status.ReadyDesired {
+			return false
+		}
+	}
+	return true
+}
+
+func revertUpgrade(app *v1.AppInstance, message string, now time.Time) {
+	failed := app.Status.AppImage
+	app.Status.AppImage = app.Status.UpgradeSoak.PreviousAppImage
+	app.Status.UpgradeSoak = nil
+	autoupgrade.RecordDecision(&app.Status, v1.UpgradeDecisionReverted, failed.Name, failed.Digest, message, now)
+}
@@ -102,6 +102,11 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 			continue
 		}
 
+		if isPerReplica(appInstance, vol) {
+			// The StatefulSet of the container creates a claim for each replica
+			continue
+		}
+
 		volumeRequest = volume.CopyVolumeDefaults(volumeRequest, volumeBinding, appInstance.Status.Defaults.Volumes[vol])
 
 		pvc := corev1.PersistentVolumeClaim{
@@ -170,6 +175,61 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 	return
 }
 
+// toVolumeClaimTemplates returns the claims that the StatefulSet of a stateful container creates for each replica
+func toVolumeClaimTemplates(req router.Request, appInstance *v1.AppInstance, name string, container v1.Container) (result []corev1.PersistentVolumeClaim, _ error) {
+	volumeClasses, _, err := volume.GetVolumeClasses(req.Ctx, req.Client, appInstance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Volumes) {
+		vol, volumeRequest := entry.Key, entry.Value
+		if !usesVolume(container, vol) || !isPerReplica(appInstance, vol) {
+			continue
+		}
+
+		volumeBinding, _ := isBind(appInstance, vol)
+		volumeRequest = volume.CopyVolumeDefaults(volumeRequest, volumeBinding, appInstance.Status.Defaults.Volumes[vol])
+
+		pvc := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: vol,
+				// The container name label marks claims that are created by a StatefulSet and not updated with the app
+				Labels: labels.Merge(volumeLabels(appInstance, vol, volumeRequest), map[string]string{
+					labels.AcornContainerName: name,
+				}),
+				Annotations: labels.GatherScoped(vol, v1.LabelTypeVolume, appInstance.Status.AppSpec.Annotations,
+					volumeRequest.Annotations, appInstance.Spec.Annotations),
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: translateAccessModes(volumeRequest.AccessModes),
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{},
+				},
+			},
+		}
+
+		if volumeRequest.Class != "" {
+			// Specifically allowing volume classes that are inactive.
+			volClass, ok := volumeClasses[volumeRequest.Class]
+			if !ok {
+				return nil, fmt.Errorf("%s has an invalid volume class %s", vol, volumeRequest.Class)
+			}
+			pvc.Spec.StorageClassName = &volClass.StorageClassName
+			pvc.Labels[labels.AcornVolumeClass] = volClass.Name
+		}
+
+		if volumeRequest.Size == "" {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.DefaultSize
+		} else {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeRequest.Size)
+		}
+
+		result = append(result, pvc)
+	}
+	return
+}
+
 func volumeLabels(appInstance *v1.AppInstance, volume string, volumeRequest v1.VolumeRequest) map[string]string {
 	labelMap := map[string]string{
 		labels.AcornAppName:      appInstance.Name,
@@ -204,6 +264,37 @@ func isBind(appInstance *v1.AppInstance, volume string) (v1.VolumeBinding, bool)
 	return v1.VolumeBinding{}, false
 }
 
+// isPerReplica returns true if each replica of a stateful container gets its own copy of the volume. Bound and
+// ephemeral volumes are shared by all replicas.
+func isPerReplica(appInstance *v1.AppInstance, volume string) bool {
+	if _, bind := isBind(appInstance, volume); bind {
+		return false
+	}
+	if _, ok := isEphemeral(appInstance, volume); ok {
+		return false
+	}
+	for _, container := range appInstance.Status.AppSpec.Containers {
+		if container.Stateful && usesVolume(container, volume) {
+			return true
+		}
+	}
+	return false
+}
+
+func usesVolume(container v1.Container, volume string) bool {
+	for _, dir := range container.Dirs {
+		if dir.ContextDir == "" && dir.Secret.Name == "" && dir.Volume == volume {
+			return true
+		}
+	}
+	for _, sidecar := range container.Sidecars {
+		if usesVolume(sidecar, volume) {
+			return true
+		}
+	}
+	return false
+}
+
 func bindName(volume string) string {
 	return name2.SafeConcatName(volume, "bind")
 }
@@ -216,6 +307,10 @@ func toVolumeName(appInstance *v1.AppInstance, volume string) (string, bool) {
 }
 
 func addVolumeReferencesForContainer(app *v1.AppInstance, volumeReferences map[volumeReference]bool, container v1.Container) {
+	if app.Spec.GetDevMode() && hasDevSync(container) {
+		volumeReferences[volumeReference{name: AcornHelper}] = true
+	}
+
 	for _, entry := range typed.Sorted(container.Dirs) {
 		volume := entry.Value
 		if volume.ContextDir != "" {
@@ -325,7 +420,10 @@ func toVolumes(appInstance *v1.AppInstance, container v1.Container) (result []co
 		}
 
 		name, bind := toVolumeName(appInstance, volume.name)
-		if vr, ok := isEphemeral(appInstance, volume.name); ok && !bind {
+		if isPerReplica(appInstance, volume.name) {
+			// Provided by the volume claim templates of the StatefulSet
+			continue
+		} else if vr, ok := isEphemeral(appInstance, volume.name); ok && !bind {
 			result = append(result, corev1.Volume{
 				Name: sanitizeVolumeName(volume.name),
 				VolumeSource: corev1.VolumeSource{
@@ -105,6 +105,9 @@ func (h *configHandler) Handle(req router.Request, resp router.Response) error {
 		if err := tls.ProvisionWildcardCert(req, resp, domain, token); err != nil {
 			return err
 		}
+		if err := tls.ProvisionClusterDomainCerts(req, resp, cfg); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
@@ -10,6 +11,7 @@ import (
 	"github.com/acorn-io/acorn/pkg/config"
 	"github.com/acorn-io/acorn/pkg/crds"
 	"github.com/acorn-io/acorn/pkg/dns"
+	"github.com/acorn-io/acorn/pkg/imageprune"
 	"github.com/acorn-io/acorn/pkg/imagesystem"
 	"github.com/acorn-io/acorn/pkg/k8sclient"
 	"github.com/acorn-io/acorn/pkg/scheme"
@@ -17,8 +19,10 @@ import (
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -31,6 +35,9 @@ type Controller struct {
 	client client.Client
 	Scheme *runtime.Scheme
 	apply  apply.Apply
+
+	restConfig        *rest.Config
+	registryTransport http.RoundTripper
 }
 
 func New() (*Controller, error) {
@@ -63,6 +70,9 @@ func New() (*Controller, error) {
 		client: client,
 		Scheme: scheme.Scheme,
 		apply:  apply,
+
+		restConfig:        cfg,
+		registryTransport: registryTransport,
 	}, nil
 }
 
@@ -95,6 +105,7 @@ func (c *Controller) Start(ctx context.Context) error {
 		go wait.UntilWithContext(ctx, dnsInit.RenewAndSync, dnsRenewPeriodHours)
 
 		autoupgrade.StartSync(ctx, c.Router.Backend())
+		imageprune.StartGC(ctx, c.Router.Backend(), c.restConfig, remote.WithTransport(c.registryTransport))
 	}()
 
 	return c.Router.Start(ctx)
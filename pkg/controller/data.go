@@ -5,7 +5,9 @@ import (
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/imagesystem"
 	"github.com/acorn-io/acorn/pkg/system"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +48,12 @@ func (c *Controller) initData(ctx context.Context) error {
 				APIGroups: []string{""},
 				Resources: []string{"services"},
 			},
+			{
+				Verbs:         []string{"get"},
+				APIGroups:     []string{coordinationv1.GroupName},
+				Resources:     []string{"leases"},
+				ResourceNames: []string{imagesystem.RegistryGCLeaseName},
+			},
 			{
 				Verbs:     []string{"get", "create", "patch"},
 				APIGroups: []string{v1.SchemeGroupVersion.Group},
@@ -32,8 +32,13 @@ func Calculate(req router.Request, resp router.Response) (err error) {
 	return nil
 }
 
+// ForApp sets the defaults of the app from its app spec, without changing its conditions
+func ForApp(req router.Request, appInstance *internalv1.AppInstance) error {
+	return calculate(req, appInstance)
+}
+
 func calculate(req router.Request, appInstance *internalv1.AppInstance) error {
-	cfg, err := config.Get(req.Ctx, req.Client)
+	cfg, err := config.GetForProject(req.Ctx, req.Client, appInstance.Namespace)
 	if err != nil {
 		return err
 	}
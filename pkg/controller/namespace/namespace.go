@@ -13,7 +13,7 @@ import (
 func AddNamespace(req router.Request, resp router.Response) error {
 	appInstance := req.Object.(*v1.AppInstance)
 
-	cfg, err := config.Get(req.Ctx, req.Client)
+	cfg, err := config.GetForProject(req.Ctx, req.Client, appInstance.Namespace)
 	if err != nil {
 		return err
 	}
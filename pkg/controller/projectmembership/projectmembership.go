@@ -0,0 +1,52 @@
+package projectmembership
+
+import (
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/roles"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/rancher/wrangler/pkg/name"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CreateRoleBindings binds the cluster roles of the roles of the membership to its member in the namespace of the
+// project. Role bindings of roles that are removed from the membership, or of deleted memberships, are cleaned up by
+// the apply of the router.
+func CreateRoleBindings(req router.Request, resp router.Response) error {
+	membership := req.Object.(*v1.ProjectMembershipInstance)
+
+	var objs []kclient.Object
+	for _, role := range membership.Spec.Roles {
+		clusterRole, ok := roles.ProjectRoles[role]
+		if !ok {
+			continue
+		}
+		objs = append(objs, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.SafeConcatName(membership.Name, role),
+				Namespace: membership.Namespace,
+				Labels: map[string]string{
+					labels.AcornManaged:           "true",
+					labels.AcornProjectMembership: membership.Name,
+				},
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup: rbacv1.GroupName,
+					Kind:     membership.Spec.Kind,
+					Name:     membership.Spec.Name,
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRole,
+			},
+		})
+	}
+
+	resp.Objects(objs...)
+	return nil
+}
@@ -0,0 +1,12 @@
+package projectmembership
+
+import (
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+)
+
+func TestCreateRoleBindings(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/rolebindings", CreateRoleBindings)
+}
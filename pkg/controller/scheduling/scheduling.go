@@ -3,6 +3,7 @@ package scheduling
 import (
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/condition"
+	"github.com/acorn-io/acorn/pkg/labels"
 	tl "github.com/acorn-io/acorn/pkg/tolerations"
 
 	adminv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
@@ -10,6 +11,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Calculate(req router.Request, resp router.Response) error {
@@ -27,6 +29,11 @@ func Calculate(req router.Request, resp router.Response) error {
 	return nil
 }
 
+// ForApp sets the scheduling of the workloads of the app from its app spec and defaults, without changing its conditions
+func ForApp(req router.Request, appInstance *v1.AppInstance) error {
+	return calculate(req, appInstance)
+}
+
 func calculate(req router.Request, appInstance *v1.AppInstance) error {
 	if appInstance.Status.Scheduling == nil {
 		appInstance.Status.Scheduling = map[string]v1.Scheduling{}
@@ -78,9 +85,10 @@ func addScheduling(req router.Request, appInstance *v1.AppInstance, workloads ma
 		})
 
 		appInstance.Status.Scheduling[name] = v1.Scheduling{
-			Requirements: *requirements,
-			Affinity:     affinity,
-			Tolerations:  tolerations,
+			Requirements:              *requirements,
+			Affinity:                  affinity,
+			Tolerations:               tolerations,
+			TopologySpreadConstraints: TopologySpreadConstraints(appInstance, name, container),
 		}
 	}
 	return nil
@@ -96,9 +104,45 @@ func Nodes(req router.Request, name string, container v1.Container, app *v1.AppI
 	return nil, nil, nil
 }
 
+// TopologySpreadConstraints spreads the replicas of the container across the topologies of its spread. Only the nodes
+// that match the affinity of the compute class of the container are counted.
+func TopologySpreadConstraints(app *v1.AppInstance, name string, container v1.Container) (result []corev1.TopologySpreadConstraint) {
+	for _, spread := range container.Spread {
+		topologyKey := spread.Topology
+		switch topologyKey {
+		case "zone":
+			topologyKey = corev1.LabelTopologyZone
+		case "node":
+			topologyKey = corev1.LabelHostname
+		}
+
+		maxSkew := spread.MaxSkew
+		if maxSkew < 1 {
+			maxSkew = 1
+		}
+
+		whenUnsatisfiable := corev1.ScheduleAnyway
+		if spread.Required {
+			whenUnsatisfiable = corev1.DoNotSchedule
+		}
+
+		honor := corev1.NodeInclusionPolicyHonor
+		result = append(result, corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: labels.Managed(app, labels.AcornContainerName, name),
+			},
+			NodeAffinityPolicy: &honor,
+		})
+	}
+	return result
+}
+
 // ResourceRequirements determines the cpu and memory amount to be set for the limits/requests of the Pod
 func ResourceRequirements(req router.Request, app *v1.AppInstance, containerName string, container v1.Container, computeClass *adminv1.ProjectComputeClassInstance) (*corev1.ResourceRequirements, error) {
-	cfg, err := config.Get(req.Ctx, req.Client)
+	cfg, err := config.GetForProject(req.Ctx, req.Client, app.Namespace)
 	if err != nil {
 		return nil, err
 	}
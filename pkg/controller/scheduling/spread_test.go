@@ -0,0 +1,12 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+)
+
+func TestSpread(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/spread", Calculate)
+}
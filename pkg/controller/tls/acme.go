@@ -0,0 +1,149 @@
+package tls
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/system"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns/rfc2136"
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DNSProviderRFC2136 = "rfc2136"
+)
+
+// ValidateConfig checks the ACME CA and DNS-01 provider settings of the config
+func ValidateConfig(cfg *apiv1.Config) error {
+	if cfg.ACMEDirectoryURL != nil && *cfg.ACMEDirectoryURL != "" {
+		u, err := url.Parse(*cfg.ACMEDirectoryURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid ACME directory URL %q: must be an http(s) URL", *cfg.ACMEDirectoryURL)
+		}
+	}
+	if provider := dnsProvider(cfg); provider != "" && provider != DNSProviderRFC2136 {
+		return fmt.Errorf("invalid ACME DNS provider %q: must be %s", provider, DNSProviderRFC2136)
+	}
+	if dnsProvider(cfg) != "" && (cfg.ACMEDNSProviderSecret == nil || *cfg.ACMEDNSProviderSecret == "") {
+		return fmt.Errorf("an ACME DNS provider secret is required for the ACME DNS provider %s", dnsProvider(cfg))
+	}
+	return nil
+}
+
+// directoryURL returns the directory URL of the ACME CA to use for the given Let's Encrypt setting
+func directoryURL(cfg *apiv1.Config) string {
+	if cfg.ACMEDirectoryURL != nil && *cfg.ACMEDirectoryURL != "" {
+		return *cfg.ACMEDirectoryURL
+	}
+	if strings.EqualFold(*cfg.LetsEncrypt, "enabled") {
+		return LetsEncryptURLProduction
+	}
+	return LetsEncryptURLStaging
+}
+
+func dnsProvider(cfg *apiv1.Config) string {
+	if cfg.ACMEDNSProvider == nil {
+		return ""
+	}
+	return strings.ToLower(*cfg.ACMEDNSProvider)
+}
+
+// getSystemSecret returns the data of a secret in the acorn-system namespace
+func getSystemSecret(ctx context.Context, c kclient.Reader, name string) (map[string][]byte, error) {
+	sec := &corev1.Secret{}
+	if err := c.Get(ctx, router.Key(system.Namespace, name), sec); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", system.Namespace, name, err)
+	}
+	return sec.Data, nil
+}
+
+// eabCredentials returns the key ID and the base64url encoded HMAC key for external account binding with the ACME CA,
+// the key ID is empty if external account binding is not configured.
+func eabCredentials(ctx context.Context, c kclient.Reader, cfg *apiv1.Config) (string, string, error) {
+	if cfg.ACMEEABSecret == nil || *cfg.ACMEEABSecret == "" {
+		return "", "", nil
+	}
+	data, err := getSystemSecret(ctx, c, *cfg.ACMEEABSecret)
+	if err != nil {
+		return "", "", err
+	}
+	kid, hmac := strings.TrimSpace(string(data["kid"])), strings.TrimSpace(string(data["hmacKey"]))
+	if kid == "" || hmac == "" {
+		return "", "", fmt.Errorf("secret %s/%s must have the keys kid and hmacKey", system.Namespace, *cfg.ACMEEABSecret)
+	}
+	return kid, hmac, nil
+}
+
+// newDNS01Provider returns the configured DNS-01 provider used to solve challenges for wildcard certificates of
+// cluster domains that are not managed by Acorn DNS
+func newDNS01Provider(ctx context.Context, c kclient.Reader, cfg *apiv1.Config) (challenge.Provider, error) {
+	switch dnsProvider(cfg) {
+	case "":
+		return nil, fmt.Errorf("no ACME DNS provider is configured")
+	case DNSProviderRFC2136:
+		if cfg.ACMEDNSProviderSecret == nil || *cfg.ACMEDNSProviderSecret == "" {
+			return nil, fmt.Errorf("no ACME DNS provider secret is configured")
+		}
+		data, err := getSystemSecret(ctx, c, *cfg.ACMEDNSProviderSecret)
+		if err != nil {
+			return nil, err
+		}
+		conf := rfc2136.NewDefaultConfig()
+		conf.Nameserver = string(data["nameserver"])
+		conf.TSIGKey = string(data["tsigKey"])
+		conf.TSIGSecret = string(data["tsigSecret"])
+		if alg := string(data["tsigAlgorithm"]); alg != "" {
+			conf.TSIGAlgorithm = alg
+		}
+		return rfc2136.NewDNSProviderConfig(conf)
+	default:
+		return nil, fmt.Errorf("unsupported ACME DNS provider %q", dnsProvider(cfg))
+	}
+}
+
+// wildcardCertSecretName returns the name of the secret of the wildcard certificate of a cluster domain
+func wildcardCertSecretName(domain string) string {
+	return system.TLSSecretName + "-" + strings.ReplaceAll(strings.TrimPrefix(clusterDomainHost(domain), "."), ".", "-")
+}
+
+// clusterDomainHost returns the cluster domain without its port
+func clusterDomainHost(domain string) string {
+	host, _, _ := strings.Cut(domain, ":")
+	return host
+}
+
+// dnsProviderDomains returns the cluster domains that get wildcard certificates through the configured DNS-01 provider
+func dnsProviderDomains(cfg *apiv1.Config) (result []string) {
+	if dnsProvider(cfg) == "" {
+		return nil
+	}
+	for _, domain := range cfg.ClusterDomains {
+		domain = strings.TrimPrefix(clusterDomainHost(domain), ".")
+		if domain == "" || strings.HasSuffix(domain, "on-acorn.io") {
+			continue
+		}
+		result = append(result, domain)
+	}
+	return result
+}
+
+// coveredByWildcard returns true if the domain is a direct subdomain of a cluster domain that gets a wildcard
+// certificate through the configured DNS-01 provider
+func coveredByWildcard(cfg *apiv1.Config, domain string) bool {
+	_, parent, ok := strings.Cut(domain, ".")
+	if !ok {
+		return false
+	}
+	for _, clusterDomain := range dnsProviderDomains(cfg) {
+		if parent == clusterDomain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package tls
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestValidateConfig(t *testing.T) {
+	assert.NoError(t, ValidateConfig(&apiv1.Config{}))
+	assert.NoError(t, ValidateConfig(&apiv1.Config{
+		ACMEDirectoryURL:      strPtr("https://ca.example.com/acme/acme/directory"),
+		ACMEDNSProvider:       strPtr("RFC2136"),
+		ACMEDNSProviderSecret: strPtr("rfc2136"),
+	}))
+	assert.Error(t, ValidateConfig(&apiv1.Config{ACMEDirectoryURL: strPtr("ca.example.com")}))
+	assert.Error(t, ValidateConfig(&apiv1.Config{ACMEDNSProvider: strPtr("route53"), ACMEDNSProviderSecret: strPtr("aws")}))
+	assert.Error(t, ValidateConfig(&apiv1.Config{ACMEDNSProvider: strPtr("rfc2136")}))
+}
+
+func TestDirectoryURL(t *testing.T) {
+	assert.Equal(t, LetsEncryptURLProduction, directoryURL(&apiv1.Config{LetsEncrypt: strPtr("enabled")}))
+	assert.Equal(t, LetsEncryptURLStaging, directoryURL(&apiv1.Config{LetsEncrypt: strPtr("staging")}))
+	assert.Equal(t, "https://ca.example.com/directory", directoryURL(&apiv1.Config{
+		LetsEncrypt:      strPtr("enabled"),
+		ACMEDirectoryURL: strPtr("https://ca.example.com/directory"),
+	}))
+}
+
+func TestCoveredByWildcard(t *testing.T) {
+	cfg := &apiv1.Config{
+		ClusterDomains:  []string{".local.on-acorn.io", ".apps.example.com:8443"},
+		ACMEDNSProvider: strPtr("rfc2136"),
+	}
+	assert.Equal(t, []string{"apps.example.com"}, dnsProviderDomains(cfg))
+	assert.Equal(t, "acorn-tls-apps-example-com", wildcardCertSecretName(".apps.example.com:8443"))
+
+	assert.True(t, coveredByWildcard(cfg, "web-app-1234.apps.example.com"))
+	assert.False(t, coveredByWildcard(cfg, "a.web.apps.example.com"))
+	assert.False(t, coveredByWildcard(cfg, "web-app-1234.local.on-acorn.io"))
+
+	cfg.ACMEDNSProvider = nil
+	assert.False(t, coveredByWildcard(cfg, "web-app-1234.apps.example.com"))
+}
+
+func TestToHash(t *testing.T) {
+	user := &LEUser{email: "admin@example.com", url: LetsEncryptURLProduction}
+	hash := user.toHash()
+
+	// The hash of Let's Encrypt users without external account binding is unchanged, so their certificates are not renewed
+	assert.Equal(t, "099ebf66352f386e85494520fbfe87150189c400", hash)
+	assert.NotEqual(t, hash, (&LEUser{email: "admin@example.com", url: "https://ca.example.com/directory"}).toHash())
+	assert.NotEqual(t, hash, (&LEUser{email: "admin@example.com", url: LetsEncryptURLProduction, eabKID: "kid"}).toHash())
+}
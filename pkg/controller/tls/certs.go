@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/config"
 	"github.com/acorn-io/acorn/pkg/labels"
@@ -44,6 +45,31 @@ func ProvisionWildcardCert(req router.Request, resp router.Response, domain, tok
 
 }
 
+// ProvisionClusterDomainCerts provisions wildcard certificates for the cluster domains that are not managed by Acorn DNS
+// using the configured DNS-01 provider
+func ProvisionClusterDomainCerts(req router.Request, resp router.Response, cfg *apiv1.Config) error {
+	domains := dnsProviderDomains(cfg)
+	if len(domains) == 0 {
+		return nil
+	}
+
+	leUser, err := ensureLEUser(req.Ctx, req.Client)
+	if err != nil {
+		logrus.Errorf("failed to get/create lets-encrypt account in ProvisionClusterDomainCerts: %v", err)
+		resp.RetryAfter(15 * time.Second)
+		return nil
+	}
+
+	var errs []error
+	for _, domain := range domains {
+		logrus.Debugf("Provisioning wildcard cert for cluster domain %v", domain)
+		if err := leUser.provisionCertIfNotExists(req.Ctx, req.Client, "*."+domain, system.Namespace, wildcardCertSecretName(domain)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
 // RequireSecretTypeTLS is a middleware that ensures that we only act on TLS-Type secrets
 func RequireSecretTypeTLS(h router.Handler) router.Handler {
 	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
@@ -148,8 +174,11 @@ func ProvisionCerts(req router.Request, resp router.Response) error {
 			continue
 		}
 
-		if err := prov(req, leUser, ep.Address, appInstance.Name, appInstanceIDSegment, appInstance.Namespace); err != nil {
-			return err
+		// Endpoints on cluster domains with a wildcard certificate don't need their own certificate
+		if !coveredByWildcard(cfg, ep.Address) {
+			if err := prov(req, leUser, ep.Address, appInstance.Name, appInstanceIDSegment, appInstance.Namespace); err != nil {
+				return err
+			}
 		}
 		provisionedCerts[ep.Address] = nil
 		ep.PublishProtocol = v1.PublishProtocolHTTPS
@@ -167,6 +196,17 @@ func ProvisionCerts(req router.Request, resp router.Response) error {
 		provisionedCerts[pb.ServiceName] = nil
 	}
 
+	for _, ds := range appInstance.Status.Domains {
+		if _, ok := provisionedCerts[ds.Domain]; ok || !ds.Verified {
+			continue
+		}
+		if err := prov(req, leUser, ds.Domain, appInstance.Name, appInstanceIDSegment, appInstance.Namespace); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		provisionedCerts[ds.Domain] = nil
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
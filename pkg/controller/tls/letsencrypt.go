@@ -57,6 +57,8 @@ type LEUser struct {
 	registration *registration.Resource
 	key          crypto.PrivateKey
 	url          string
+	eabKID       string
+	eabHMAC      string
 }
 
 func fromSecret(secret *corev1.Secret) (*LEUser, error) {
@@ -77,6 +79,7 @@ func fromSecret(secret *corev1.Secret) (*LEUser, error) {
 		registration: &reg,
 		key:          privateKey,
 		url:          string(secret.Data["url"]),
+		eabKID:       string(secret.Data["eabKid"]),
 	}, nil
 }
 
@@ -116,7 +119,16 @@ func (u *LEUser) register() error {
 		return err
 	}
 
-	reg, err := client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	var reg *registration.Resource
+	if u.eabKID != "" {
+		reg, err = client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+			TermsOfServiceAgreed: true,
+			Kid:                  u.eabKID,
+			HmacEncoded:          u.eabHMAC,
+		})
+	} else {
+		reg, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	}
 	if err != nil {
 		return err
 	}
@@ -151,6 +163,7 @@ func (u *LEUser) toSecret() (*corev1.Secret, error) {
 			"privateKey":   pemEncoded,
 			"registration": reg,
 			"url":          []byte(u.url),
+			"eabKid":       []byte(u.eabKID),
 		},
 	}, nil
 }
@@ -172,22 +185,29 @@ func (u *LEUser) leClient() (*lego.Client, error) {
 	return client, nil
 }
 
+// matchLeURLToEnv returns the Let's Encrypt environment of the URL, or the URL itself for other ACME CAs
 func matchLeURLToEnv(url string) string {
 	if url == LetsEncryptURLStaging {
 		return "staging"
 	} else if url == LetsEncryptURLProduction {
 		return "enabled"
-	} else {
+	} else if url == "" {
 		return "disabled"
+	} else {
+		return url
 	}
 }
 
 // toHash returns a hash of the configurable fields of the LEUser
 // It is used to determine if the LEUser has changed and needs to be updated.
-// For this, we only check the email and url fields, since the key and registration are generated
-// and identify the user against the ACME server.
+// For this, we only check the email, url and external account key ID fields, since the key and registration are
+// generated and identify the user against the ACME server.
 func (u *LEUser) toHash() string {
-	toHash := []byte(name.Limit(fmt.Sprintf("%s-%s", matchLeURLToEnv(u.url), u.email), 63))
+	settings := fmt.Sprintf("%s-%s", matchLeURLToEnv(u.url), u.email)
+	if u.eabKID != "" {
+		settings += "-" + u.eabKID
+	}
+	toHash := []byte(name.Limit(settings, 63))
 	dig := sha1.New()
 	dig.Write([]byte(toHash))
 	return hex.EncodeToString(dig.Sum(nil))
@@ -204,20 +224,23 @@ func ensureLEUser(ctx context.Context, client kclient.Client) (*LEUser, error) {
 	 * Construct new LE User
 	 */
 	email := "staging-certs@acorn.io"
-	url := LetsEncryptURLStaging
-	if strings.EqualFold(*cfg.LetsEncrypt, "enabled") {
-		url = LetsEncryptURLProduction
-		if cfg.LetsEncryptEmail == "" {
-			return nil, fmt.Errorf("let's encrypt email is required")
-		}
+	if strings.EqualFold(*cfg.LetsEncrypt, "enabled") && cfg.LetsEncryptEmail == "" {
+		return nil, fmt.Errorf("let's encrypt email is required")
 	}
 	if cfg.LetsEncryptEmail != "" {
 		email = cfg.LetsEncryptEmail
 	}
 
+	eabKID, eabHMAC, err := eabCredentials(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	newLEUser := &LEUser{
-		email: email,
-		url:   url,
+		email:   email,
+		url:     directoryURL(cfg),
+		eabKID:  eabKID,
+		eabHMAC: eabHMAC,
 	}
 
 	newLEUserHash := newLEUser.toHash()
@@ -385,10 +408,6 @@ func (u *LEUser) mustRenew(sec *corev1.Secret) bool {
 }
 
 func (u *LEUser) dnsChallenge(ctx context.Context, domain string) (*certificate.Resource, error) {
-	if !strings.HasSuffix(domain, "on-acorn.io") {
-		return nil, fmt.Errorf("ACME DNS challenge is only supported for on-acorn.io subdomains, not for %s", domain)
-	}
-
 	client, err := u.leClient()
 	if err != nil {
 		return nil, err
@@ -405,6 +424,21 @@ func (u *LEUser) dnsChallenge(ctx context.Context, domain string) (*certificate.
 		return nil, err
 	}
 
+	if !strings.HasSuffix(domain, "on-acorn.io") {
+		// Domains that are not managed by Acorn DNS are solved by the configured DNS-01 provider
+		dnsProvider, err := newDNS01Provider(ctx, c, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("ACME DNS challenge for %s: %w", domain, err)
+		}
+		if err := client.Challenge.SetDNS01Provider(dnsProvider); err != nil {
+			return nil, err
+		}
+		return client.Certificate.Obtain(certificate.ObtainRequest{
+			Domains: []string{strings.TrimPrefix(domain, ".")},
+			Bundle:  true,
+		})
+	}
+
 	dnsEndpoint := *cfg.AcornDNSEndpoint
 
 	dnsSecret := &corev1.Secret{}
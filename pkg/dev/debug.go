@@ -0,0 +1,162 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/client"
+	objwatcher "github.com/acorn-io/baaah/pkg/watcher"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func debugForwardLoop(ctx context.Context, client client.Client, app *apiv1.App) error {
+	for {
+		err := debugForward(ctx, client, app)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logrus.Errorf("failed to forward debug ports: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// debugForward forwards the debug port of each running container of the app with a dev.debug block to localhost
+func debugForward(ctx context.Context, client client.Client, app *apiv1.App) error {
+	lock := sync.Mutex{}
+	forwarding := map[string]bool{}
+	wc, err := client.GetClient()
+	if err != nil {
+		return err
+	}
+	w := objwatcher.New[*apiv1.ContainerReplica](wc)
+	_, err = w.BySelector(ctx, app.Namespace, labels.Everything(), func(con *apiv1.ContainerReplica) (bool, error) {
+		if con.Spec.AppName != app.Name || con.Spec.JobName != "" || con.Status.Phase != corev1.PodRunning {
+			return false, nil
+		}
+		if con.Spec.Dev == nil || con.Spec.Dev.Debug == nil || con.Spec.Dev.Debug.Port <= 0 {
+			return false, nil
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		if forwarding[con.Name] {
+			return false, nil
+		}
+		forwarding[con.Name] = true
+
+		con = con.DeepCopy()
+		go func() {
+			if err := forwardDebugPort(ctx, client, con); err != nil && !errors.Is(err, context.Canceled) {
+				logrus.Errorf("Failed to forward debug port of %s: %v", con.Name, err)
+			}
+			lock.Lock()
+			delete(forwarding, con.Name)
+			lock.Unlock()
+		}()
+		return false, nil
+	})
+	return err
+}
+
+// listenDebugPort listens on the same port on localhost as in the container, or on a random port if it's in use
+func listenDebugPort(port int32) (net.Listener, error) {
+	l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err == nil {
+		return l, nil
+	}
+	return net.Listen("tcp", "127.0.0.1:0")
+}
+
+// forwardDebugPort forwards connections to localhost to the debug port of the container until the container stops
+// running or the context is canceled
+func forwardDebugPort(ctx context.Context, client client.Client, con *apiv1.ContainerReplica) error {
+	port := con.Spec.Dev.Debug.Port
+	dialer, err := client.ContainerReplicaPortForward(ctx, con.Name, int(port))
+	if err != nil {
+		return err
+	}
+
+	l, err := listenDebugPort(port)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Debugger of %s: attach to %s", con.Name, l.Addr().String())
+	return serveDebugPort(ctx, client, con.Name, l, dialer)
+}
+
+func serveDebugPort(ctx context.Context, client client.Client, name string, l net.Listener, dialer client.PortForwardDialer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+	go func() {
+		defer cancel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(2 * time.Second):
+			}
+			con, err := client.ContainerReplicaGet(ctx, name)
+			if apierrors.IsNotFound(err) || (err == nil && con.Status.Phase != corev1.PodRunning) {
+				return
+			}
+		}
+	}()
+
+	for {
+		local, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			if err := pipeDebugConn(ctx, local, dialer); err != nil {
+				logrus.Errorf("Debug connection to %s failed: %v", name, err)
+			}
+		}()
+	}
+}
+
+func pipeDebugConn(ctx context.Context, local net.Conn, dialer client.PortForwardDialer) error {
+	defer local.Close()
+
+	remote, err := dialer(ctx)
+	if err != nil {
+		return err
+	}
+	defer remote.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(remote, local)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(local, remote)
+		done <- struct{}{}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case <-done:
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package dev
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListenDebugPort(t *testing.T) {
+	used, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer used.Close()
+
+	// The port is in use, so a random one is picked
+	l, err := listenDebugPort(int32(used.Addr().(*net.TCPAddr).Port))
+	require.NoError(t, err)
+	defer l.Close()
+	assert.NotEqual(t, used.Addr().String(), l.Addr().String())
+}
+
+func TestForwardDebugPort(t *testing.T) {
+	// The debugger in the container echoes lines
+	debugger, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer debugger.Close()
+	go func() {
+		conn, err := debugger.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		_, _ = conn.Write([]byte(line))
+	}()
+
+	con := &apiv1.ContainerReplica{
+		ObjectMeta: metav1.ObjectMeta{Name: "app.web-1234"},
+		Status:     apiv1.ContainerReplicaStatus{Phase: corev1.PodRunning},
+	}
+	c := mocks.NewMockClient(gomock.NewController(t))
+	c.EXPECT().ContainerReplicaGet(gomock.Any(), "app.web-1234").Return(con, nil).AnyTimes()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serveDebugPort(ctx, c, con.Name, l, func(ctx context.Context) (net.Conn, error) {
+			return net.Dial("tcp", debugger.Addr().String())
+		})
+	}()
+
+	local, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer local.Close()
+	_, err = local.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	line, err := bufio.NewReader(local).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", line)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
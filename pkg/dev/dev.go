@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kterm "k8s.io/kubectl/pkg/util/term"
 )
 
 type Options struct {
@@ -38,6 +40,11 @@ type Options struct {
 	Log               client.LogOptions
 	Dangerous         bool
 	BidirectionalSync bool
+	TUI               bool
+
+	ui *tui
+	// prefix is prepended to the container names in logs and status, to tell apart the apps of a workspace
+	prefix string
 }
 
 func (o *Options) complete() (*Options, error) {
@@ -166,6 +173,10 @@ func buildLoop(ctx context.Context, client client.Client, file string, opts *Opt
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	if opts.ui != nil {
+		opts.ui.setRebuild(watcher.Trigger)
+	}
+
 outer:
 	for {
 		if err := watcher.Wait(ctx); err != nil {
@@ -181,7 +192,13 @@ outer:
 		}
 
 		opts.Build.Args = params
+		if opts.ui != nil {
+			opts.ui.building()
+		}
 		image, err := client.AcornImageBuild(ctx, file, &opts.Build)
+		if opts.ui != nil {
+			opts.ui.built(err)
+		}
 		if err != nil {
 			logrus.Errorf("Failed to build %s: %v", file, err)
 			logrus.Infof("Build failed, touch [%s] to rebuild", file)
@@ -216,14 +233,21 @@ outer:
 
 		opts.Run.Name = app.Name
 		eg, ctx := errgroup.WithContext(ctx)
+		if opts.ui != nil {
+			opts.ui.start(ctx, eg, app, &opts.Log)
+		} else {
+			eg.Go(func() error {
+				return logLoop(ctx, client, app, &opts.Log, opts.prefix)
+			})
+			eg.Go(func() error {
+				return appStatusLoop(ctx, client, app, opts.prefix)
+			})
+		}
 		eg.Go(func() error {
-			return LogLoop(ctx, client, app, &opts.Log)
-		})
-		eg.Go(func() error {
-			return AppStatusLoop(ctx, client, app)
+			return containerSyncLoop(ctx, client, app, opts)
 		})
 		eg.Go(func() error {
-			return containerSyncLoop(ctx, client, app, opts)
+			return debugForwardLoop(ctx, client, app)
 		})
 		eg.Go(func() error {
 			return appDeleteStop(ctx, client, app, cancel)
@@ -393,7 +417,14 @@ func appStatusMessage(app *apiv1.App) (string, bool) {
 }
 
 func PrintAppStatus(app *apiv1.App) {
+	printAppStatus(app, "")
+}
+
+func printAppStatus(app *apiv1.App, prefix string) {
 	msg, ready := appStatusMessage(app)
+	if prefix != "" {
+		msg = strings.TrimSuffix(prefix, ".") + " " + msg
+	}
 	if ready {
 		pterm.DefaultBox.Println(pterm.LightGreen(msg))
 	} else {
@@ -402,6 +433,10 @@ func PrintAppStatus(app *apiv1.App) {
 }
 
 func AppStatusLoop(ctx context.Context, c client.Client, app *apiv1.App) error {
+	return appStatusLoop(ctx, c, app, "")
+}
+
+func appStatusLoop(ctx context.Context, c client.Client, app *apiv1.App, prefix string) error {
 	wc, err := c.GetClient()
 	if err != nil {
 		return err
@@ -413,7 +448,7 @@ func AppStatusLoop(ctx context.Context, c client.Client, app *apiv1.App) error {
 		logrus.Debugf("app status loop %s/%s rev=%s, generation=%d, observed=%d: newMsg=%s, newReady=%v", app.Namespace, app.Name,
 			app.ResourceVersion, app.Generation, app.Status.ObservedGeneration, newMsg, newReady)
 		if newMsg != msg || newReady != ready {
-			PrintAppStatus(app)
+			printAppStatus(app, prefix)
 		}
 		msg, ready = newMsg, newReady
 
@@ -424,12 +459,16 @@ func AppStatusLoop(ctx context.Context, c client.Client, app *apiv1.App) error {
 }
 
 func LogLoop(ctx context.Context, c client.Client, app *apiv1.App, opts *client.LogOptions) error {
+	return logLoop(ctx, c, app, opts, "")
+}
+
+func logLoop(ctx context.Context, c client.Client, app *apiv1.App, opts *client.LogOptions, prefix string) error {
 	for {
 		if opts == nil {
 			opts = &client.LogOptions{}
 		}
 		opts.Follow = true
-		_ = log.Output(ctx, c, app.Name, opts)
+		_ = log.OutputWithPrefix(ctx, c, app.Name, prefix, opts)
 
 		select {
 		case <-ctx.Done():
@@ -475,9 +514,40 @@ func Dev(ctx context.Context, client client.Client, file string, opts *Options)
 	opts.Run.Profiles = append([]string{"dev?"}, opts.Run.Profiles...)
 	opts.Build.Profiles = append([]string{"dev?"}, opts.Build.Profiles...)
 
+	if opts.TUI {
+		if kterm.IsTerminal(os.Stdout) {
+			return devTUI(ctx, client, acornCue, opts)
+		}
+		logrus.Warn("Not showing the terminal UI, stdout is not a terminal")
+	}
+
 	err = buildLoop(ctx, client, acornCue, opts)
 	if errors.Is(err, context.Canceled) {
 		return nil
 	}
 	return err
 }
+
+// devTUI runs the build loop while showing the terminal UI, quitting the UI stops dev mode
+func devTUI(ctx context.Context, client client.Client, file string, opts *Options) error {
+	opts.ui = newTUI(client)
+	opts.Build.Streams = opts.ui.buildOutput()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- opts.ui.run(ctx, cancel)
+	}()
+
+	err := buildLoop(ctx, client, file, opts)
+	cancel()
+	if uiErr := <-done; uiErr != nil && err == nil {
+		err = uiErr
+	}
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
@@ -3,6 +3,7 @@ package dev
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/client"
 	"github.com/acorn-io/acorn/pkg/controller/appdefinition"
 	objwatcher "github.com/acorn-io/baaah/pkg/watcher"
@@ -46,22 +48,33 @@ func containerSync(ctx context.Context, client client.Client, app *apiv1.App, op
 	if err != nil {
 		return err
 	}
+	var syncOut io.Writer
+	if opts.ui != nil {
+		syncOut = opts.ui.syncOutput()
+	}
 	w := objwatcher.New[*apiv1.ContainerReplica](wc)
 	_, err = w.BySelector(ctx, app.Namespace, labels.Everything(), func(con *apiv1.ContainerReplica) (bool, error) {
 		if con.Spec.AppName == app.Name && con.Spec.JobName == "" && con.Status.Phase == corev1.PodRunning && !syncing[con.Name] {
 			if con.Spec.Init {
 				return false, nil
 			}
-			for remoteDir, mount := range con.Spec.Dirs {
-				if mount.ContextDir == "" {
-					continue
+			var devSync *v1.DevSync
+			if con.Spec.Dev != nil && con.Spec.Dev.Sync != nil {
+				devSync = con.Spec.Dev.Sync
+				if _, err := postSyncExec(devSync); err != nil {
+					// Still sync the files, the container just isn't signaled
+					logrus.Errorf("%s: %v", con.Name, err)
+					devSync = devSync.DeepCopy()
+					devSync.Signal = ""
 				}
+			}
+			for remoteDir, localDir := range syncPaths(con) {
 				var (
 					remoteDir = remoteDir
-					mount     = mount
+					localDir  = localDir
 				)
 				go func() {
-					startSyncForPath(ctx, client, con, opts.Build.Cwd, mount.ContextDir, remoteDir, opts.BidirectionalSync)
+					startSyncForPath(ctx, client, con, opts.Build.Cwd, localDir, remoteDir, opts.BidirectionalSync, devSync, syncOut)
 					syncLock.Lock()
 					delete(syncing, con.Name)
 					syncLock.Unlock()
@@ -76,7 +89,65 @@ func containerSync(ctx context.Context, client client.Client, app *apiv1.App, op
 	return err
 }
 
-func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) (chan struct{}, chan error, error) {
+// syncPaths returns the local directory to sync to each directory of the container. These are the dirs of the
+// container with a context dir and the paths of its dev sync policy.
+func syncPaths(con *apiv1.ContainerReplica) map[string]string {
+	result := map[string]string{}
+	if con.Spec.Dev != nil && con.Spec.Dev.Sync != nil {
+		for remoteDir, localDir := range con.Spec.Dev.Sync.Paths {
+			result[remoteDir] = localDir
+		}
+	}
+	for remoteDir, mount := range con.Spec.Dirs {
+		if mount.ContextDir != "" {
+			result[remoteDir] = mount.ContextDir
+		}
+	}
+	return result
+}
+
+// postSyncExec returns the commands to run in the container after a batch of changes is synced
+func postSyncExec(devSync *v1.DevSync) ([]latest.SyncExec, error) {
+	if devSync == nil {
+		return nil, nil
+	}
+
+	var result []latest.SyncExec
+	if len(devSync.Command) > 0 {
+		result = append(result, latest.SyncExec{
+			Name:    strings.Join(devSync.Command, " "),
+			Command: devSync.Command[0],
+			// Args must not be nil, otherwise the command is run with "sh -c"
+			Args: append([]string{}, devSync.Command[1:]...),
+		})
+	}
+	if devSync.Signal != "" {
+		signal, err := normalizeSignal(devSync.Signal)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, latest.SyncExec{
+			Name:    "signal " + signal,
+			Command: "kill",
+			Args:    []string{"-s", signal, "1"},
+		})
+	}
+	return result, nil
+}
+
+var reloadSignals = []string{"HUP", "INT", "QUIT", "TERM", "USR1", "USR2"}
+
+func normalizeSignal(signal string) (string, error) {
+	result := strings.TrimPrefix(strings.ToUpper(signal), "SIG")
+	for _, s := range reloadSignals {
+		if s == result {
+			return result, nil
+		}
+	}
+	return "", fmt.Errorf("invalid dev sync signal %s, must be one of %s", signal, strings.Join(reloadSignals, ", "))
+}
+
+func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool, devSync *v1.DevSync, syncOut io.Writer) (chan struct{}, chan error, error) {
 	source := filepath.Join(cwd, localDir)
 	if s, err := os.Stat(source); err == nil && !s.IsDir() {
 		return nil, nil, nil
@@ -100,13 +171,21 @@ func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv
 		logrus.Warnf("failed to open %s for syncing: %v", filepath.Join(cwd, ".dockerignore"), err)
 		exclude = nil
 	}
+	exec, err := postSyncExec(devSync)
+	if err != nil {
+		return nil, nil, err
+	}
+	if devSync != nil {
+		exclude = append(exclude, devSync.Ignore...)
+	}
 	s, err := sync.NewSync(ctx, source, sync.Options{
+		Exec:               exec,
 		DownstreamDisabled: !bidirectional,
 		Polling:            true,
 		Verbose:            true,
 		UploadExcludePaths: exclude,
 		InitialSync:        latest.InitialSyncStrategyPreferLocal,
-		Log: newLogger().
+		Log: newLogger(syncOut).
 			WithPrefix(strings.TrimPrefix(con.Name, con.Spec.AppName+".") + ": (sync): "),
 	})
 	if err != nil {
@@ -143,7 +222,15 @@ func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv
 	return done, waiterr, nil
 }
 
-func newLogger() logpkg.Logger {
+// newLogger returns the logger of the sync, it writes to stdout and stderr unless out is set
+func newLogger(out io.Writer) logpkg.Logger {
+	if out != nil {
+		return logpkg.NewStreamLogger(&ignore{
+			Out: out,
+		}, &ignore{
+			Out: out,
+		}, logrus.InfoLevel)
+	}
 	return logpkg.NewStreamLogger(&ignore{
 		Out: os.Stdout,
 	}, &ignore{
@@ -162,7 +249,7 @@ func (i *ignore) Write(p []byte) (n int, err error) {
 	return i.Out.Write(p)
 }
 
-func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) {
+func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool, devSync *v1.DevSync, syncOut io.Writer) {
 	for {
 		var (
 			wait    <-chan struct{}
@@ -174,7 +261,7 @@ func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.Cont
 			return
 		}
 		if err == nil {
-			wait, waiterr, err = invokeStartSyncForPath(ctx, client, con, cwd, localDir, remoteDir, bidirectional)
+			wait, waiterr, err = invokeStartSyncForPath(ctx, client, con, cwd, localDir, remoteDir, bidirectional, devSync, syncOut)
 		}
 
 		if err == nil {
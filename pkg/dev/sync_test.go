@@ -0,0 +1,67 @@
+package dev
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/loft-sh/devspace/pkg/devspace/config/versions/latest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncPaths(t *testing.T) {
+	con := &apiv1.ContainerReplica{
+		Spec: apiv1.ContainerReplicaSpec{
+			Dirs: map[string]v1.VolumeMount{
+				"/app":  {ContextDir: "./"},
+				"/data": {Volume: "data"},
+			},
+			Dev: &v1.Dev{
+				Sync: &v1.DevSync{
+					Paths: map[string]string{
+						"/srv/templates": "./templates",
+						"/app":           "./ignored",
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"/app":           "./",
+		"/srv/templates": "./templates",
+	}, syncPaths(con))
+}
+
+func TestPostSyncExec(t *testing.T) {
+	exec, err := postSyncExec(nil)
+	require.NoError(t, err)
+	assert.Empty(t, exec)
+
+	exec, err = postSyncExec(&v1.DevSync{
+		Command: []string{"touch", "/app/reload"},
+		Signal:  "sighup",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []latest.SyncExec{
+		{
+			Name:    "touch /app/reload",
+			Command: "touch",
+			Args:    []string{"/app/reload"},
+		},
+		{
+			Name:    "signal HUP",
+			Command: "kill",
+			Args:    []string{"-s", "HUP", "1"},
+		},
+	}, exec)
+
+	// A command without arguments must not be run in a shell
+	exec, err = postSyncExec(&v1.DevSync{Command: []string{"reload"}})
+	require.NoError(t, err)
+	assert.NotNil(t, exec[0].Args)
+
+	_, err = postSyncExec(&v1.DevSync{Signal: "KILL"})
+	assert.Error(t, err)
+}
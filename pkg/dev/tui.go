@@ -0,0 +1,624 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/client/term"
+	"github.com/acorn-io/acorn/pkg/streams"
+	objwatcher "github.com/acorn-io/baaah/pkg/watcher"
+	"github.com/pterm/pterm"
+	"github.com/sirupsen/logrus"
+	kterm "k8s.io/kubectl/pkg/util/term"
+)
+
+const (
+	maxLogLines = 2000
+
+	clearScreen     = "\x1b[H\x1b[2J"
+	enterAltScreen  = "\x1b[?1049h"
+	leaveAltScreen  = "\x1b[?1049l"
+	hideCursor      = "\x1b[?25l"
+	showCursor      = "\x1b[?25h"
+	tuiHelp         = "↑/↓ select  r restart  s shell  b rebuild  q quit"
+	syncLogSep      = ": (sync): "
+	allContainers   = ""
+	eventsContainer = "acorn"
+)
+
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+type logLine struct {
+	container string
+	line      string
+}
+
+// tui is the interactive terminal UI of dev mode. It shows the containers of the app with their readiness, the logs
+// of the selected container, and the progress of builds and syncs.
+type tui struct {
+	client client.Client
+	in     io.Reader
+	out    io.Writer
+
+	lock       sync.Mutex
+	appName    string
+	status     string
+	ready      bool
+	containers []apiv1.ContainerReplica
+	selected   string
+	logs       []logLine
+	build      string
+	syncs      map[string]string
+	message    string
+	rebuild    func()
+	execIn     io.WriteCloser
+
+	dirty chan struct{}
+}
+
+func newTUI(c client.Client) *tui {
+	return &tui{
+		client: c,
+		in:     os.Stdin,
+		out:    os.Stdout,
+		syncs:  map[string]string{},
+		dirty:  make(chan struct{}, 1),
+	}
+}
+
+func (t *tui) changed() {
+	select {
+	case t.dirty <- struct{}{}:
+	default:
+	}
+}
+
+func (t *tui) update(f func()) {
+	t.lock.Lock()
+	f()
+	t.lock.Unlock()
+	t.changed()
+}
+
+func (t *tui) addLog(container, line string) {
+	t.update(func() {
+		t.logs = append(t.logs, logLine{container: container, line: line})
+		if len(t.logs) > maxLogLines {
+			t.logs = t.logs[len(t.logs)-maxLogLines:]
+		}
+	})
+}
+
+func (t *tui) setRebuild(rebuild func()) {
+	t.update(func() {
+		t.rebuild = rebuild
+	})
+}
+
+func (t *tui) building() {
+	t.update(func() {
+		t.build = "building..."
+	})
+}
+
+func (t *tui) built(err error) {
+	t.update(func() {
+		if err != nil {
+			t.build = fmt.Sprintf("failed at %s: %v", time.Now().Format(time.Kitchen), err)
+		} else {
+			t.build = "built at " + time.Now().Format(time.Kitchen)
+		}
+	})
+}
+
+// buildOutput returns the streams that build progress is written to
+func (t *tui) buildOutput() *streams.Output {
+	w := &lineWriter{line: func(line string) {
+		t.update(func() {
+			t.build = "building: " + line
+		})
+	}}
+	return &streams.Output{Out: w, Err: w}
+}
+
+// syncOutput returns the writer that sync progress is written to
+func (t *tui) syncOutput() io.Writer {
+	return &lineWriter{line: t.syncLine}
+}
+
+func (t *tui) syncLine(line string) {
+	prefix, msg, ok := strings.Cut(line, syncLogSep)
+	fields := strings.Fields(prefix)
+	if !ok || len(fields) == 0 {
+		return
+	}
+	// The prefix is the name of the container without the app name, it may be preceded by the log level
+	container := fields[len(fields)-1]
+	t.update(func() {
+		t.syncs[t.appName+"."+container] = msg
+	})
+}
+
+// eventOutput returns the writer that the messages of dev mode itself are written to
+func (t *tui) eventOutput() io.Writer {
+	return &lineWriter{line: func(line string) {
+		t.addLog(eventsContainer, line)
+	}}
+}
+
+// start runs the loops that keep the status, containers and logs of the app up to date until the context is canceled
+func (t *tui) start(ctx context.Context, eg interface{ Go(func() error) }, app *apiv1.App, opts *client.LogOptions) {
+	t.update(func() {
+		t.appName = app.Name
+	})
+	eg.Go(func() error {
+		return t.statusLoop(ctx, app)
+	})
+	eg.Go(func() error {
+		return t.containerLoop(ctx, app)
+	})
+	eg.Go(func() error {
+		return t.logLoop(ctx, app, opts)
+	})
+}
+
+func (t *tui) statusLoop(ctx context.Context, app *apiv1.App) error {
+	wc, err := t.client.GetClient()
+	if err != nil {
+		return err
+	}
+	w := objwatcher.New[*apiv1.App](wc)
+	_, err = w.ByObject(ctx, app, func(app *apiv1.App) (bool, error) {
+		msg, ready := appStatusMessage(app)
+		t.update(func() {
+			t.status, t.ready = msg, ready
+		})
+		// Return false because the context will be canceled when this check should stop.
+		return false, nil
+	})
+	return err
+}
+
+func (t *tui) containerLoop(ctx context.Context, app *apiv1.App) error {
+	for {
+		containers, err := t.client.ContainerReplicaList(ctx, &client.ContainerReplicaListOptions{
+			App: app.Name,
+		})
+		if err == nil {
+			sort.Slice(containers, func(i, j int) bool {
+				return containers[i].Name < containers[j].Name
+			})
+			t.update(func() {
+				t.containers = containers
+			})
+		} else {
+			logrus.Debugf("failed to list containers of %s: %v", app.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func (t *tui) logLoop(ctx context.Context, app *apiv1.App, opts *client.LogOptions) error {
+	for {
+		logOpts := &client.LogOptions{}
+		if opts != nil {
+			*logOpts = *opts
+		}
+		logOpts.Follow = true
+
+		msgs, err := t.client.AppLog(ctx, app.Name, logOpts)
+		if err == nil {
+			for msg := range msgs {
+				if msg.Error != "" {
+					if !strings.Contains(msg.Error, "context canceled") {
+						t.addLog(eventsContainer, msg.Error)
+					}
+					continue
+				}
+				t.addLog(app.Name+"."+msg.ContainerName, ansiEscape.ReplaceAllString(msg.Line, ""))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// run shows the UI until the context is canceled or the user quits, in which case cancel is called
+func (t *tui) run(ctx context.Context, cancel func()) error {
+	logOutput, logFormatter := logrus.StandardLogger().Out, logrus.StandardLogger().Formatter
+	logrus.SetOutput(t.eventOutput())
+	logrus.SetFormatter(eventFormatter{})
+	defer func() {
+		logrus.SetOutput(logOutput)
+		logrus.SetFormatter(logFormatter)
+	}()
+
+	tty := kterm.TTY{In: t.in, Out: t.out, Raw: true}
+	return tty.Safe(func() error {
+		_, _ = io.WriteString(t.out, enterAltScreen+hideCursor)
+		defer func() {
+			_, _ = io.WriteString(t.out, showCursor+leaveAltScreen)
+		}()
+
+		keys := make(chan []byte)
+		go t.readKeys(ctx, keys)
+
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+
+		dirty := true
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case key := <-keys:
+				if t.toShell(key) {
+					continue
+				}
+				if !t.handleKey(ctx, key) {
+					cancel()
+					return nil
+				}
+				dirty = true
+			case <-t.dirty:
+				dirty = true
+			case <-ticker.C:
+				if dirty && !t.execing() {
+					width, height := t.size()
+					_, _ = io.WriteString(t.out, t.render(width, height))
+					dirty = false
+				}
+			}
+		}
+	})
+}
+
+func (t *tui) readKeys(ctx context.Context, keys chan<- []byte) {
+	buf := make([]byte, 64)
+	for {
+		n, err := t.in.Read(buf)
+		if err != nil {
+			return
+		}
+		select {
+		case keys <- append([]byte{}, buf[:n]...):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *tui) size() (int, int) {
+	if f, ok := t.out.(*os.File); ok {
+		if size := kterm.GetSize(f.Fd()); size != nil && size.Width > 0 && size.Height > 0 {
+			return int(size.Width), int(size.Height)
+		}
+	}
+	return 80, 24
+}
+
+// handleKey acts on a key press and returns false if the UI should quit
+func (t *tui) handleKey(ctx context.Context, key []byte) bool {
+	switch {
+	case bytes.Equal(key, []byte("q")), bytes.Equal(key, []byte{3}):
+		return false
+	case bytes.Equal(key, []byte("k")), bytes.Equal(key, []byte("\x1b[A")):
+		t.move(-1)
+	case bytes.Equal(key, []byte("j")), bytes.Equal(key, []byte("\x1b[B")):
+		t.move(1)
+	case bytes.Equal(key, []byte("b")):
+		t.lock.Lock()
+		rebuild := t.rebuild
+		t.lock.Unlock()
+		if rebuild != nil {
+			t.setMessage("rebuild requested")
+			rebuild()
+		}
+	case bytes.Equal(key, []byte("r")):
+		if name := t.selectedContainer(); name != "" {
+			if _, err := t.client.ContainerReplicaDelete(ctx, name); err != nil {
+				t.setMessage(fmt.Sprintf("failed to restart %s: %v", name, err))
+			} else {
+				t.setMessage("restarting " + name)
+			}
+		} else {
+			t.setMessage("select a container to restart")
+		}
+	case bytes.Equal(key, []byte("s")):
+		if name := t.selectedContainer(); name != "" {
+			t.shell(ctx, name)
+		} else {
+			t.setMessage("select a container to open a shell in")
+		}
+	}
+	return true
+}
+
+func (t *tui) setMessage(msg string) {
+	t.update(func() {
+		t.message = msg
+	})
+}
+
+// move moves the selection, the first entry of the list shows the logs of all containers
+func (t *tui) move(delta int) {
+	t.update(func() {
+		names := []string{allContainers}
+		current := 0
+		for _, con := range t.containers {
+			if con.Name == t.selected {
+				current = len(names)
+			}
+			names = append(names, con.Name)
+		}
+		next := current + delta
+		if next < 0 {
+			next = 0
+		} else if next >= len(names) {
+			next = len(names) - 1
+		}
+		t.selected = names[next]
+	})
+}
+
+func (t *tui) selectedContainer() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, con := range t.containers {
+		if con.Name == t.selected {
+			return con.Name
+		}
+	}
+	return ""
+}
+
+func (t *tui) execing() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.execIn != nil
+}
+
+// toShell passes the key to the open shell and returns false if no shell is open
+func (t *tui) toShell(key []byte) bool {
+	t.lock.Lock()
+	execIn := t.execIn
+	t.lock.Unlock()
+	if execIn == nil {
+		return false
+	}
+	_, _ = execIn.Write(key)
+	return true
+}
+
+// shell opens an interactive shell in the container, the UI is hidden until the shell exits. Input goes to the
+// shell as soon as it is requested, so keys typed while the exec starts are not lost.
+func (t *tui) shell(ctx context.Context, name string) {
+	in, execIn := io.Pipe()
+	t.lock.Lock()
+	t.execIn = execIn
+	t.lock.Unlock()
+
+	_, _ = io.WriteString(t.out, clearScreen+showCursor)
+	_, _ = fmt.Fprintf(t.out, "Opening a shell in %s, exit the shell to return\r\n", name)
+
+	go func() {
+		err := t.exec(ctx, name, in)
+		_ = in.Close()
+		_, _ = io.WriteString(t.out, hideCursor)
+		t.update(func() {
+			t.execIn = nil
+			if err != nil {
+				t.message = fmt.Sprintf("shell in %s failed: %v", name, err)
+			}
+		})
+	}()
+}
+
+func (t *tui) exec(ctx context.Context, name string, in io.Reader) error {
+	cIO, err := t.client.ContainerReplicaExec(ctx, name, nil, true, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = term.Pipe(cIO, &streams.Streams{
+		In: in,
+		Output: streams.Output{
+			Out: t.out,
+			Err: t.out,
+		},
+	})
+	return err
+}
+
+// render returns the escape sequences and text that draw the UI on a terminal of the given size
+func (t *tui) render(width, height int) string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var lines []string
+	add := func(line string) {
+		lines = append(lines, truncate(line, width))
+	}
+
+	status := t.status
+	if status == "" {
+		status = "waiting for app"
+	}
+	if t.ready {
+		status = pterm.LightGreen(status)
+	} else {
+		status = pterm.LightYellow(status)
+	}
+	add(pterm.Bold.Sprint(t.appName) + " " + status)
+
+	// Leave room for the header, separator, log pane, build, sync and help lines
+	listHeight := height / 3
+	entries := append([]string{allContainers}, containerNames(t.containers)...)
+	start := 0
+	for i, name := range entries {
+		if name == t.selected && i >= listHeight {
+			start = i - listHeight + 1
+		}
+	}
+	for i := start; i < len(entries) && i < start+listHeight; i++ {
+		add(t.containerLine(entries[i], i-1))
+	}
+
+	title := "logs: all containers"
+	if t.selected != allContainers {
+		title = "logs: " + t.selected
+	}
+	add("── " + title + " " + strings.Repeat("─", width))
+
+	logHeight := height - len(lines) - 4
+	logs := t.selectedLogs(logHeight)
+	for i := 0; i < logHeight; i++ {
+		if i < len(logs) {
+			add(logs[i])
+		} else {
+			add("")
+		}
+	}
+
+	build := t.build
+	if build == "" {
+		build = "waiting"
+	}
+	add("Build: " + build)
+	syncStatus := "-"
+	if t.selected != allContainers && t.syncs[t.selected] != "" {
+		syncStatus = t.syncs[t.selected]
+	}
+	add("Sync:  " + syncStatus)
+	if t.message != "" {
+		add(pterm.Gray(tuiHelp + "  |  " + t.message))
+	} else {
+		add(pterm.Gray(tuiHelp))
+	}
+
+	return clearScreen + strings.Join(lines, "\r\n")
+}
+
+func (t *tui) containerLine(name string, index int) string {
+	marker := "  "
+	if name == t.selected {
+		marker = "> "
+	}
+	if index < 0 {
+		return marker + "all containers"
+	}
+
+	con := t.containers[index]
+	ready := pterm.LightYellow("not ready")
+	if con.Status.Ready {
+		ready = pterm.LightGreen("ready")
+	}
+	return fmt.Sprintf("%s%s  %s  %s  restarts=%d", marker, con.Name, con.Status.Phase, ready, con.Status.RestartCount)
+}
+
+// selectedLogs returns the last count log lines of the selected container, or of all containers prefixed with the
+// container name
+func (t *tui) selectedLogs(count int) []string {
+	var result []string
+	for i := len(t.logs) - 1; i >= 0 && len(result) < count; i-- {
+		log := t.logs[i]
+		if t.selected == allContainers {
+			result = append(result, strings.TrimPrefix(log.container, t.appName+".")+": "+log.line)
+		} else if log.container == t.selected {
+			result = append(result, log.line)
+		}
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+func containerNames(containers []apiv1.ContainerReplica) []string {
+	result := make([]string, 0, len(containers))
+	for _, con := range containers {
+		result = append(result, con.Name)
+	}
+	return result
+}
+
+// truncate cuts the line to the width of the terminal, escape sequences don't count towards the width
+func truncate(line string, width int) string {
+	line = strings.ReplaceAll(strings.ReplaceAll(line, "\r", ""), "\t", "    ")
+
+	var (
+		result  strings.Builder
+		visible int
+	)
+	for line != "" {
+		if strings.HasPrefix(line, "\x1b[") {
+			if loc := ansiEscape.FindStringIndex(line); loc != nil && loc[0] == 0 {
+				result.WriteString(line[:loc[1]])
+				line = line[loc[1]:]
+				continue
+			}
+		}
+		if visible == width {
+			result.WriteString("\x1b[0m")
+			break
+		}
+		r, size := utf8.DecodeRuneInString(line)
+		result.WriteRune(r)
+		line = line[size:]
+		visible++
+	}
+	return result.String()
+}
+
+// lineWriter calls line for each complete line written to it, escape sequences are removed
+type lineWriter struct {
+	lock sync.Mutex
+	buf  []byte
+	line func(string)
+}
+
+func (l *lineWriter) Write(p []byte) (int, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.buf = append(l.buf, p...)
+	for {
+		i := bytes.IndexByte(l.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimSpace(ansiEscape.ReplaceAllString(string(l.buf[:i]), ""))
+		l.buf = l.buf[i+1:]
+		if line != "" {
+			l.line(line)
+		}
+	}
+	return len(p), nil
+}
+
+// eventFormatter formats the messages of dev mode for the log pane
+type eventFormatter struct{}
+
+func (eventFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	return []byte(strings.ToUpper(entry.Level.String()) + " " + entry.Message + "\n"), nil
+}
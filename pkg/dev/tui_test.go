@@ -0,0 +1,128 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/acorn-io/acorn/pkg/client/term"
+	"github.com/acorn-io/acorn/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testTUI(t *testing.T) (*tui, *mocks.MockClient) {
+	c := mocks.NewMockClient(gomock.NewController(t))
+	ui := newTUI(c)
+	ui.appName = "app"
+	ui.containers = []apiv1.ContainerReplica{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app.web-1234"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app.db-5678"}, Status: apiv1.ContainerReplicaStatus{Ready: true}},
+	}
+	return ui, c
+}
+
+func TestTUIMove(t *testing.T) {
+	ui, _ := testTUI(t)
+
+	ui.move(-1)
+	assert.Equal(t, allContainers, ui.selected)
+	ui.move(1)
+	assert.Equal(t, "app.web-1234", ui.selected)
+	ui.move(5)
+	assert.Equal(t, "app.db-5678", ui.selected)
+	ui.move(-1)
+	assert.Equal(t, "app.web-1234", ui.selected)
+}
+
+func TestTUIRender(t *testing.T) {
+	ui, _ := testTUI(t)
+	ui.addLog("app.web-1234", "listening on :5000")
+	ui.addLog("app.db-5678", "ready to accept connections")
+	ui.syncLine("web-1234: (sync): Upstream - Upload 1 create change(s)")
+
+	screen := ansiEscape.ReplaceAllString(ui.render(80, 24), "")
+	lines := strings.Split(strings.TrimPrefix(screen, clearScreen), "\r\n")
+	assert.Len(t, lines, 23)
+	assert.Contains(t, screen, "> all containers")
+	assert.Contains(t, screen, "web-1234: listening on :5000")
+	assert.Contains(t, screen, "db-5678: ready to accept connections")
+	assert.Contains(t, lines[len(lines)-2], "Sync:  -")
+
+	ui.move(1)
+	screen = ansiEscape.ReplaceAllString(ui.render(80, 24), "")
+	assert.Contains(t, screen, "logs: app.web-1234")
+	assert.Contains(t, screen, "listening on :5000")
+	assert.NotContains(t, screen, "ready to accept connections")
+	assert.Contains(t, screen, "Sync:  Upstream - Upload 1 create change(s)")
+}
+
+func TestTUIRestart(t *testing.T) {
+	ui, c := testTUI(t)
+
+	assert.True(t, ui.handleKey(context.Background(), []byte("r")))
+	assert.Equal(t, "select a container to restart", ui.message)
+
+	c.EXPECT().ContainerReplicaDelete(gomock.Any(), "app.web-1234").Return(&ui.containers[0], nil)
+	ui.move(1)
+	assert.True(t, ui.handleKey(context.Background(), []byte("r")))
+	assert.Equal(t, "restarting app.web-1234", ui.message)
+
+	assert.False(t, ui.handleKey(context.Background(), []byte("q")))
+}
+
+func TestTUIShell(t *testing.T) {
+	ui, c := testTUI(t)
+	ui.out = io.Discard
+	ui.move(1)
+
+	started := make(chan struct{})
+	c.EXPECT().ContainerReplicaExec(gomock.Any(), "app.web-1234", nil, true, nil).DoAndReturn(
+		func(context.Context, string, []string, bool, *client.ContainerReplicaExecOptions) (*term.ExecIO, error) {
+			<-started
+			return nil, errors.New("no shell")
+		})
+
+	// Input belongs to the shell before the exec has started
+	assert.True(t, ui.handleKey(context.Background(), []byte("s")))
+	assert.True(t, ui.execing())
+
+	close(started)
+	assert.Eventually(t, func() bool {
+		return !ui.execing()
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "shell in app.web-1234 failed: no shell", ui.message)
+	assert.False(t, ui.toShell([]byte("q")))
+}
+
+func TestTUIRebuild(t *testing.T) {
+	ui, _ := testTUI(t)
+	rebuilt := false
+	ui.setRebuild(func() {
+		rebuilt = true
+	})
+	assert.True(t, ui.handleKey(context.Background(), []byte("b")))
+	assert.True(t, rebuilt)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc\x1b[0m", truncate("abcdef", 3))
+	assert.Equal(t, "\x1b[1mab\x1b[0m", truncate("\x1b[1mab\x1b[0m", 3))
+	assert.Equal(t, "a    b", truncate("a\tb", 10))
+}
+
+func TestLineWriter(t *testing.T) {
+	var lines []string
+	w := &lineWriter{line: func(line string) {
+		lines = append(lines, line)
+	}}
+	_, _ = w.Write([]byte("\x1b[32mfirst\x1b[0m\nsec"))
+	_, _ = w.Write([]byte("ond\n\n"))
+	assert.Equal(t, []string{"first", "second"}, lines)
+}
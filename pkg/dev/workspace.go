@@ -0,0 +1,147 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/yaml"
+)
+
+// Workspace is a set of apps that are developed together, each with its own Acornfile
+type Workspace struct {
+	Apps map[string]WorkspaceApp `json:"apps,omitempty"`
+}
+
+// WorkspaceApp is an app of a workspace, the key of the app in the workspace is the name of the app
+type WorkspaceApp struct {
+	// Dir is the build context of the app relative to the workspace file, it defaults to the name of the app
+	Dir string `json:"dir,omitempty"`
+	// File is the Acornfile of the app relative to Dir, it defaults to Acornfile
+	File string `json:"file,omitempty"`
+	// Args are the deploy args of the app
+	Args []string `json:"args,omitempty"`
+	// Links are the apps linked as services in this app (format app-name[:service-name]). App names that are not in
+	// the workspace refer to existing apps.
+	Links []string `json:"links,omitempty"`
+}
+
+// ReadWorkspace reads a workspace file and resolves the directories of its apps
+func ReadWorkspace(file string) (*Workspace, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &Workspace{}
+	if err := yaml.UnmarshalStrict(data, ws); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace %s: %w", file, err)
+	}
+	if len(ws.Apps) == 0 {
+		return nil, fmt.Errorf("workspace %s has no apps", file)
+	}
+
+	base := filepath.Dir(file)
+	for name, app := range ws.Apps {
+		if app.Dir == "" {
+			app.Dir = name
+		}
+		if !filepath.IsAbs(app.Dir) {
+			app.Dir = filepath.Join(base, app.Dir)
+		}
+		if app.File == "" {
+			app.File = "Acornfile"
+		}
+		if !filepath.IsAbs(app.File) {
+			app.File = filepath.Join(app.Dir, app.File)
+		}
+		ws.Apps[name] = app
+	}
+
+	return ws, nil
+}
+
+// links returns the service bindings of the app, the names of workspace apps are the names of the apps
+func (w WorkspaceApp) links() ([]v1.ServiceBinding, error) {
+	var args []string
+	for _, link := range w.Links {
+		if !strings.Contains(link, ":") {
+			link = link + ":" + link
+		}
+		args = append(args, link)
+	}
+	return v1.ParseLinks(args)
+}
+
+// options returns the dev options of the app, based on the options that apply to all apps of the workspace
+func (w WorkspaceApp) options(name string, opts *Options) (*Options, error) {
+	links, err := w.links()
+	if err != nil {
+		return nil, fmt.Errorf("invalid links of %s: %w", name, err)
+	}
+
+	result := *opts
+	result.Args = append([]string{w.Dir}, w.Args...)
+	result.Build.Cwd = w.Dir
+	result.Build.Profiles = append([]string{}, opts.Build.Profiles...)
+	result.Run.Name = name
+	result.Run.Profiles = append([]string{}, opts.Run.Profiles...)
+	result.Run.Labels = append([]v1.ScopedLabel{}, opts.Run.Labels...)
+	result.Run.Annotations = append([]v1.ScopedLabel{}, opts.Run.Annotations...)
+	result.Run.Links = append(append([]v1.ServiceBinding{}, opts.Run.Links...), links...)
+	result.prefix = name + "."
+	return &result, nil
+}
+
+// DevWorkspace builds and runs all apps of the workspace in dev mode until the context is canceled or one of them is
+// deleted, then all apps are stopped
+func DevWorkspace(ctx context.Context, client client.Client, file string, opts *Options) error {
+	ws, err := ReadWorkspace(file)
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+	if opts.TUI {
+		logrus.Warn("Not showing the terminal UI, it is not supported for workspaces")
+		opts.TUI = false
+	}
+
+	names := make([]string, 0, len(ws.Apps))
+	for name := range ws.Apps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, name := range names {
+		appOpts, err := ws.Apps[name].options(name, opts)
+		if err != nil {
+			return err
+		}
+		file := ws.Apps[name].File
+		eg.Go(func() error {
+			err := Dev(ctx, client, file, appOpts)
+			if err == nil {
+				// Stop the other apps when the app is deleted
+				err = context.Canceled
+			}
+			return err
+		})
+	}
+
+	err = eg.Wait()
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
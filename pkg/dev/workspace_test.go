@@ -0,0 +1,65 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWorkspace = `
+apps:
+  api:
+    args: ["--replicas", "2"]
+    links: ["db", "cache:redis"]
+  db:
+    dir: ../db
+    file: dev/Acornfile
+`
+
+func TestReadWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "acorn-workspace.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(testWorkspace), 0644))
+
+	ws, err := ReadWorkspace(file)
+	require.NoError(t, err)
+	assert.Equal(t, WorkspaceApp{
+		Dir:   filepath.Join(dir, "api"),
+		File:  filepath.Join(dir, "api", "Acornfile"),
+		Args:  []string{"--replicas", "2"},
+		Links: []string{"db", "cache:redis"},
+	}, ws.Apps["api"])
+	assert.Equal(t, WorkspaceApp{
+		Dir:  filepath.Join(filepath.Dir(dir), "db"),
+		File: filepath.Join(filepath.Dir(dir), "db", "dev", "Acornfile"),
+	}, ws.Apps["db"])
+
+	opts, err := ws.Apps["api"].options("api", &Options{
+		Run: client.AppRunOptions{
+			Links: []v1.ServiceBinding{{Target: "auth", Service: "auth-app"}},
+		},
+		Dangerous: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "api", opts.Run.Name)
+	assert.Equal(t, filepath.Join(dir, "api"), opts.Build.Cwd)
+	assert.True(t, opts.Dangerous)
+	assert.Equal(t, []v1.ServiceBinding{
+		{Target: "auth", Service: "auth-app"},
+		{Target: "db", Service: "db"},
+		{Target: "redis", Service: "cache"},
+	}, opts.Run.Links)
+
+	require.NoError(t, os.WriteFile(file, []byte("apps: {}"), 0644))
+	_, err = ReadWorkspace(file)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(file, []byte("apps: {api: {unknown: true}}"), 0644))
+	_, err = ReadWorkspace(file)
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/autoupgrade"
 	"github.com/acorn-io/acorn/pkg/images"
 	"github.com/acorn-io/acorn/pkg/tags"
@@ -31,6 +32,7 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		}
 	}
 
+	var scanStatus *v1.ImageScanStatus
 	image := &apiv1.Image{}
 	err := c.Get(ctx, router.Key(namespace, name), image)
 	if err != nil && !apierror.IsNotFound(err) {
@@ -40,6 +42,7 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 	} else if err == nil {
 		namespace = image.Namespace
 		imageName = image.Name
+		scanStatus = image.ScanStatus
 	}
 
 	appImage, err := images.PullAppImage(ctx, c, namespace, imageName, opts...)
@@ -55,6 +58,7 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 				Namespace: namespace,
 			},
 			ParseError: err.Error(),
+			ScanStatus: scanStatus,
 		}, nil
 	}
 
@@ -68,5 +72,6 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		Params:     details.Params,
 		AppSpec:    details.AppSpec,
 		AppImage:   *appImage,
+		ScanStatus: scanStatus,
 	}, nil
 }
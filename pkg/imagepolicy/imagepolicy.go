@@ -0,0 +1,165 @@
+package imagepolicy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/tags"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/google/go-containerregistry/pkg/name"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// List returns all the image policies that apply to the namespace, project policies first followed by cluster policies
+func List(ctx context.Context, c kclient.Reader, namespace string) ([]adminv1.ProjectImagePolicyInstance, error) {
+	projectPolicies := &adminv1.ProjectImagePolicyInstanceList{}
+	if err := c.List(ctx, projectPolicies, &kclient.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	clusterPolicies := &adminv1.ClusterImagePolicyInstanceList{}
+	if err := c.List(ctx, clusterPolicies); err != nil {
+		return nil, err
+	}
+
+	result := make([]adminv1.ProjectImagePolicyInstance, 0, len(projectPolicies.Items)+len(clusterPolicies.Items))
+	result = append(result, projectPolicies.Items...)
+	for _, policy := range clusterPolicies.Items {
+		result = append(result, (adminv1.ProjectImagePolicyInstance)(policy))
+	}
+	return result, nil
+}
+
+// CheckImageAllowed returns an error if the image is denied by the image policies that apply to the namespace.
+// Local images are checked by the remote image they were pulled from. Images built in the cluster were not pulled from
+// anywhere and are not checked.
+func CheckImageAllowed(ctx context.Context, c kclient.Client, namespace, image string) error {
+	policies, err := List(ctx, c, namespace)
+	if err != nil {
+		return err
+	}
+
+	if !hasImageRules(policies) {
+		return nil
+	}
+
+	id, local, err := tags.ResolveLocal(ctx, c, namespace, image)
+	if err != nil {
+		return err
+	}
+	if !local {
+		return checkImageAllowed(policies, image)
+	}
+
+	imageInstance := &v1.ImageInstance{}
+	if err := c.Get(ctx, router.Key(namespace, id), imageInstance); err != nil {
+		return err
+	}
+	if imageInstance.Source == "" {
+		return nil
+	}
+	return checkImageAllowed(policies, imageInstance.Source)
+}
+
+func hasImageRules(policies []adminv1.ProjectImagePolicyInstance) bool {
+	for _, policy := range policies {
+		if len(policy.AllowedImages) > 0 || len(policy.DeniedImages) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func checkImageAllowed(policies []adminv1.ProjectImagePolicyInstance, image string) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return err
+	}
+
+	var (
+		hasAllowRules bool
+		allowed       bool
+	)
+	for _, policy := range policies {
+		for _, pattern := range policy.DeniedImages {
+			if Matches(pattern, ref) {
+				return fmt.Errorf("image %s is denied by image policy %s", image, policy.Name)
+			}
+		}
+		if len(policy.AllowedImages) > 0 {
+			hasAllowRules = true
+		}
+		for _, pattern := range policy.AllowedImages {
+			if Matches(pattern, ref) {
+				allowed = true
+			}
+		}
+	}
+
+	if hasAllowRules && !allowed {
+		return fmt.Errorf("image %s is not allowed by any image policy", image)
+	}
+	return nil
+}
+
+// Matches returns true if the pattern matches the fully qualified name of the image, with or without the tag or
+// digest. Patterns are globs where * matches any characters except / and ** matches any characters.
+// Images on Docker Hub can be matched using either docker.io or index.docker.io as the registry.
+func Matches(pattern string, ref name.Reference) bool {
+	re, err := globToRegexp(pattern)
+	if err != nil {
+		return false
+	}
+
+	candidates := []string{ref.Name(), ref.Context().Name()}
+	if ref.Context().RegistryStr() == name.DefaultRegistry {
+		candidates = append(candidates,
+			"docker.io"+strings.TrimPrefix(ref.Name(), name.DefaultRegistry),
+			"docker.io"+strings.TrimPrefix(ref.Context().Name(), name.DefaultRegistry))
+	}
+
+	for _, candidate := range candidates {
+		if re.MatchString(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePattern returns an error if the pattern is not a valid image pattern
+func ValidatePattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("pattern must not be empty")
+	}
+	if strings.ContainsAny(pattern, " \t\n") {
+		return fmt.Errorf("pattern must not contain whitespace")
+	}
+	_, err := globToRegexp(pattern)
+	return err
+}
+
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	buf := strings.Builder{}
+	buf.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				buf.WriteString(".*")
+				i++
+			} else {
+				buf.WriteString("[^/]*")
+			}
+		case '?':
+			buf.WriteString("[^/]")
+		default:
+			buf.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
@@ -0,0 +1,152 @@
+package imagepolicy
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		pattern, image string
+		expected       bool
+	}{
+		{"ghcr.io/acorn-io/app", "ghcr.io/acorn-io/app:v1", true},
+		{"ghcr.io/acorn-io/app", "ghcr.io/acorn-io/other:v1", false},
+		{"ghcr.io/acorn-io/*", "ghcr.io/acorn-io/app:v1", true},
+		{"ghcr.io/acorn-io/*", "ghcr.io/acorn-io/nested/app:v1", false},
+		{"ghcr.io/acorn-io/**", "ghcr.io/acorn-io/nested/app:v1", true},
+		{"ghcr.io/**", "docker.io/acorn/app:v1", false},
+		{"ghcr.io/acorn-io/app:v1.*", "ghcr.io/acorn-io/app:v1.2", true},
+		{"ghcr.io/acorn-io/app:v1.*", "ghcr.io/acorn-io/app:v2.0", false},
+		{"ghcr.io/acorn-io/app:v?", "ghcr.io/acorn-io/app:v2", true},
+		{"docker.io/library/nginx", "nginx", true},
+		{"index.docker.io/library/nginx:latest", "nginx", true},
+		{"docker.io/acorn/**", "acorn/app:v1", true},
+		{"*.example.com/**", "registry.example.com/team/app:v1", true},
+		{"*.example.com/**", "example.com/team/app:v1", false},
+	}
+	for _, tt := range tests {
+		ref, err := name.ParseReference(tt.image)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equalf(t, tt.expected, Matches(tt.pattern, ref), "pattern %s, image %s", tt.pattern, tt.image)
+	}
+}
+
+func TestCheckImageAllowed(t *testing.T) {
+	policy := func(name string, allowed, denied []string) adminv1.ProjectImagePolicyInstance {
+		return adminv1.ProjectImagePolicyInstance{
+			ObjectMeta:    metav1.ObjectMeta{Name: name},
+			AllowedImages: allowed,
+			DeniedImages:  denied,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		policies []adminv1.ProjectImagePolicyInstance
+		image    string
+		wantErr  string
+	}{
+		{
+			name:  "no policies",
+			image: "ghcr.io/acorn-io/app:v1",
+		},
+		{
+			name:     "policies with only public keys",
+			policies: []adminv1.ProjectImagePolicyInstance{{PublicKeys: []string{"key"}}},
+			image:    "ghcr.io/acorn-io/app:v1",
+		},
+		{
+			name:     "allowed",
+			policies: []adminv1.ProjectImagePolicyInstance{policy("project", []string{"ghcr.io/acorn-io/**"}, nil)},
+			image:    "ghcr.io/acorn-io/app:v1",
+		},
+		{
+			name:     "not allowed",
+			policies: []adminv1.ProjectImagePolicyInstance{policy("project", []string{"ghcr.io/acorn-io/**"}, nil)},
+			image:    "docker.io/acorn/app:v1",
+			wantErr:  "image docker.io/acorn/app:v1 is not allowed by any image policy",
+		},
+		{
+			name: "allowed by one of many",
+			policies: []adminv1.ProjectImagePolicyInstance{
+				policy("project", []string{"ghcr.io/acorn-io/**"}, nil),
+				policy("cluster", []string{"docker.io/acorn/**"}, nil),
+			},
+			image: "docker.io/acorn/app:v1",
+		},
+		{
+			name: "denied wins over allowed",
+			policies: []adminv1.ProjectImagePolicyInstance{
+				policy("project", []string{"ghcr.io/acorn-io/**"}, nil),
+				policy("cluster", nil, []string{"ghcr.io/acorn-io/app:*-dev"}),
+			},
+			image:   "ghcr.io/acorn-io/app:v1-dev",
+			wantErr: "image ghcr.io/acorn-io/app:v1-dev is denied by image policy cluster",
+		},
+		{
+			name:     "deny only",
+			policies: []adminv1.ProjectImagePolicyInstance{policy("project", nil, []string{"docker.io/**"})},
+			image:    "ghcr.io/acorn-io/app:v1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkImageAllowed(tt.policies, tt.image)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type uncachedClient struct {
+	kclient.Client
+}
+
+func (c uncachedClient) Get(ctx context.Context, key kclient.ObjectKey, obj kclient.Object) error {
+	return c.Client.Get(ctx, key, uncached.Unwrap(obj).(kclient.Object))
+}
+
+func TestCheckImageAllowedLocal(t *testing.T) {
+	const (
+		builtID  = "1111111111111111111111111111111111111111111111111111111111111111"
+		pulledID = "2222222222222222222222222222222222222222222222222222222222222222"
+	)
+
+	c := uncachedClient{Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&adminv1.ProjectImagePolicyInstance{
+			ObjectMeta:    metav1.ObjectMeta{Name: "project", Namespace: "acorn"},
+			AllowedImages: []string{"ghcr.io/acorn-io/**"},
+		},
+		&apiv1.Image{ObjectMeta: metav1.ObjectMeta{Name: builtID, Namespace: "acorn"}, Digest: "sha256:" + builtID},
+		&apiv1.Image{ObjectMeta: metav1.ObjectMeta{Name: pulledID, Namespace: "acorn"}, Digest: "sha256:" + pulledID},
+		&v1.ImageInstance{ObjectMeta: metav1.ObjectMeta{Name: builtID, Namespace: "acorn"}, Digest: "sha256:" + builtID},
+		&v1.ImageInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: pulledID, Namespace: "acorn"},
+			Digest:     "sha256:" + pulledID,
+			Source:     "docker.io/acorn/app:v1",
+		},
+	).Build()}
+
+	assert.NoError(t, CheckImageAllowed(context.Background(), c, "acorn", builtID))
+	assert.EqualError(t, CheckImageAllowed(context.Background(), c, "acorn", pulledID),
+		"image docker.io/acorn/app:v1 is not allowed by any image policy")
+	assert.NoError(t, CheckImageAllowed(context.Background(), c, "acorn", "ghcr.io/acorn-io/app:v1"))
+	assert.Error(t, CheckImageAllowed(context.Background(), c, "acorn", "3333333333333333333333333333333333333333333333333333333333333333"))
+}
@@ -0,0 +1,87 @@
+package imageprune
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StartGC periodically prunes the images of every namespace according to the retention policy and then garbage
+// collects the blobs of the internal registry.
+func StartGC(ctx context.Context, c kclient.Client, cfg *rest.Config, opts ...remote.Option) {
+	go func() {
+		for {
+			next := gc(ctx, c, cfg, opts...)
+			select {
+			case <-time.After(next):
+			case <-ctx.Done():
+				logrus.Infof("Exiting image garbage collection daemon")
+				return
+			}
+		}
+	}()
+}
+
+func gc(ctx context.Context, c kclient.Client, restConfig *rest.Config, opts ...remote.Option) time.Duration {
+	next, _ := time.ParseDuration(config.DefaultImageGCIntervalDefault)
+
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		logrus.Errorf("Failed to get config for image garbage collection: %v", err)
+		return next
+	}
+
+	if interval, err := time.ParseDuration(*cfg.ImageGCInterval); err != nil || interval <= 0 {
+		logrus.Warnf("Invalid image garbage collection interval %s, using default of %s", *cfg.ImageGCInterval, next)
+	} else {
+		next = interval
+	}
+
+	if policy, err := PolicyFromConfig(cfg); err != nil {
+		logrus.Errorf("Invalid image retention policy: %v", err)
+		return next
+	} else if !policy.Enabled() {
+		return next
+	}
+
+	imageList := &v1.ImageInstanceList{}
+	if err := c.List(ctx, imageList); err != nil {
+		logrus.Errorf("Failed to list images for garbage collection: %v", err)
+		return next
+	}
+
+	var (
+		seen   = map[string]bool{}
+		pruned int
+	)
+	for _, image := range imageList.Items {
+		if seen[image.Namespace] {
+			continue
+		}
+		seen[image.Namespace] = true
+
+		images, err := Prune(ctx, c, image.Namespace, false, opts...)
+		if err != nil {
+			logrus.Errorf("Failed to prune images in namespace %s: %v", image.Namespace, err)
+		}
+		for _, image := range images {
+			logrus.Infof("Pruned image %s/%s", image.Namespace, image.Name)
+		}
+		pruned += len(images)
+	}
+
+	// The internal registry is only deployed when no external registry prefix is configured.
+	if pruned > 0 && *cfg.InternalRegistryPrefix == "" {
+		if err := GarbageCollectRegistry(ctx, c, restConfig); err != nil {
+			logrus.Errorf("Failed to garbage collect internal registry: %v", err)
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,309 @@
+package imageprune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagesystem"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/tags"
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Policy is the image retention policy. Images that are tagged or referenced by an app are always kept.
+type Policy struct {
+	// KeepCount is the number of most recent untagged images to keep per repository, zero means no count limit
+	KeepCount int
+	// MaxAge is the age after which an untagged image can be pruned, zero means no age limit
+	MaxAge time.Duration
+}
+
+// Enabled returns true if the policy would ever prune an image
+func (p Policy) Enabled() bool {
+	return p.KeepCount > 0 || p.MaxAge > 0
+}
+
+// PolicyFromConfig returns the retention policy configured for the cluster
+func PolicyFromConfig(cfg *apiv1.Config) (Policy, error) {
+	var policy Policy
+	if cfg.ImageRetentionCount != nil {
+		if *cfg.ImageRetentionCount < 0 {
+			return policy, fmt.Errorf("invalid image retention count %d: must not be negative", *cfg.ImageRetentionCount)
+		}
+		policy.KeepCount = *cfg.ImageRetentionCount
+	}
+	if cfg.ImageRetentionMaxAge != nil && *cfg.ImageRetentionMaxAge != "" {
+		maxAge, err := time.ParseDuration(*cfg.ImageRetentionMaxAge)
+		if err != nil {
+			return policy, fmt.Errorf("invalid image retention max age %s: %w", *cfg.ImageRetentionMaxAge, err)
+		} else if maxAge < 0 {
+			return policy, fmt.Errorf("invalid image retention max age %s: must not be negative", *cfg.ImageRetentionMaxAge)
+		}
+		policy.MaxAge = maxAge
+	}
+	return policy, nil
+}
+
+// ValidateConfig returns an error if the image retention policy or garbage collection interval of the config is invalid
+func ValidateConfig(cfg *apiv1.Config) error {
+	if _, err := PolicyFromConfig(cfg); err != nil {
+		return err
+	}
+	if cfg.ImageGCInterval != nil && *cfg.ImageGCInterval != "" {
+		if interval, err := time.ParseDuration(*cfg.ImageGCInterval); err != nil {
+			return fmt.Errorf("invalid image garbage collection interval %s: %w", *cfg.ImageGCInterval, err)
+		} else if interval <= 0 {
+			return fmt.Errorf("invalid image garbage collection interval %s: must be positive", *cfg.ImageGCInterval)
+		}
+	}
+	return nil
+}
+
+// Candidates returns the images that can be pruned under the policy. An image is a candidate if it has no tags, is
+// not referenced by any of the apps, is not one of the policy's KeepCount most recent images of its repository and is
+// older than the policy's MaxAge.
+func Candidates(imgs []v1.ImageInstance, apps []v1.AppInstance, policy Policy, now time.Time) []v1.ImageInstance {
+	if !policy.Enabled() {
+		return nil
+	}
+
+	byRepo := map[string][]v1.ImageInstance{}
+	for _, image := range imgs {
+		repo := repository(image)
+		byRepo[repo] = append(byRepo[repo], image)
+	}
+
+	var result []v1.ImageInstance
+	for _, repoImages := range byRepo {
+		sort.SliceStable(repoImages, func(i, j int) bool {
+			return repoImages[j].CreationTimestamp.Before(&repoImages[i].CreationTimestamp)
+		})
+
+		var kept int
+		for _, image := range repoImages {
+			if len(image.Tags) > 0 || referenced(image, apps) {
+				continue
+			}
+			if kept < policy.KeepCount {
+				kept++
+				continue
+			}
+			if policy.MaxAge > 0 && now.Sub(image.CreationTimestamp.Time) < policy.MaxAge {
+				continue
+			}
+			result = append(result, image)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// repository returns the repository the image was last tagged in. Images that were never tagged are grouped by the
+// repository they are stored in.
+func repository(image v1.ImageInstance) string {
+	if repo := image.Annotations[labels.AcornImageTagRepository]; repo != "" {
+		return repo
+	}
+	return image.Repo
+}
+
+func referenced(image v1.ImageInstance, apps []v1.AppInstance) bool {
+	for _, app := range apps {
+		if app.Status.AppImage.ID == image.Name ||
+			(image.Digest != "" && app.Status.AppImage.Digest == image.Digest) {
+			return true
+		}
+
+		spec := strings.TrimPrefix(app.Spec.Image, "sha256:")
+		if spec == image.Name || (tags.SHAPermissivePrefixPattern.MatchString(spec) && strings.HasPrefix(image.Name, spec)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune deletes the images in the namespace that can be pruned under the cluster's retention policy and returns them.
+// The manifests of pruned images and of their containers are deleted from the internal registry, unless they are
+// shared with an image that is kept, but the blobs they reference remain until the registry is garbage collected. If
+// dryRun is true, the images are returned but nothing is deleted.
+func Prune(ctx context.Context, c kclient.Client, namespace string, dryRun bool, opts ...remote.Option) ([]v1.ImageInstance, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := PolicyFromConfig(cfg)
+	if err != nil || !policy.Enabled() {
+		return nil, err
+	}
+
+	imageList := &v1.ImageInstanceList{}
+	if err := c.List(ctx, imageList, &kclient.ListOptions{
+		Namespace: namespace,
+	}); err != nil {
+		return nil, err
+	}
+
+	appList := &v1.AppInstanceList{}
+	if err := c.List(ctx, appList, &kclient.ListOptions{
+		Namespace: namespace,
+	}); err != nil {
+		return nil, err
+	}
+
+	candidates := Candidates(imageList.Items, appList.Items, policy, time.Now())
+	if dryRun || len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	keep, err := keptManifests(ctx, c, imageList.Items, candidates, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, image := range candidates {
+		if err := deleteManifests(ctx, c, image, keep, opts...); err != nil {
+			return nil, fmt.Errorf("deleting manifests of image %s: %w", image.Name, err)
+		}
+		if err := c.Delete(ctx, &image); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return candidates, nil
+}
+
+// keptManifests returns the digests of the manifests of the images in the internal registry that are not pruned.
+// Images built from the same containers share manifests, which must not be deleted with a pruned image.
+func keptManifests(ctx context.Context, c kclient.Client, imgs, candidates []v1.ImageInstance, opts ...remote.Option) (map[string]bool, error) {
+	pruned := map[string]bool{}
+	for _, image := range candidates {
+		pruned[image.Name] = true
+	}
+
+	result := map[string]bool{}
+	for _, image := range imgs {
+		if image.Repo != "" || pruned[image.Name] {
+			continue
+		}
+
+		_, index, err := internalIndex(ctx, c, image, opts...)
+		if err != nil {
+			return nil, err
+		} else if index == nil {
+			continue
+		}
+
+		result[image.Digest] = true
+		if err := manifestDigests(index, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// deleteManifests deletes the manifest of an image that is stored in the internal registry and the manifests of its
+// containers that are not in keep. Images recorded with an explicit repository are not owned by the internal registry
+// and are left alone.
+func deleteManifests(ctx context.Context, c kclient.Client, image v1.ImageInstance, keep map[string]bool, opts ...remote.Option) error {
+	if image.Repo != "" {
+		return nil
+	}
+
+	ref, index, err := internalIndex(ctx, c, image, opts...)
+	if err != nil || index == nil {
+		return err
+	}
+
+	children := map[string]bool{}
+	if err := manifestDigests(index, children); err != nil {
+		return err
+	}
+
+	// The image index goes first, so that a failure leaves unreferenced manifests behind rather than a broken image
+	if err := deleteManifest(ref, opts...); err != nil {
+		return err
+	}
+	for _, digest := range typed.SortedKeys(children) {
+		if keep[digest] {
+			continue
+		}
+		if err := deleteManifest(ref.Context().Digest(digest), opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// internalIndex returns the reference and index of an image in the internal registry, or a nil index if the image is
+// no longer in the registry. The options must authenticate to the internal registry.
+func internalIndex(ctx context.Context, c kclient.Client, image v1.ImageInstance, opts ...remote.Option) (name.Reference, ggcrv1.ImageIndex, error) {
+	ref, err := imagesystem.GetInternalRepoForNamespaceAndID(ctx, c, image.Namespace, image.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	index, err := remote.Index(ref, opts...)
+	if isNotFound(err) {
+		return ref, nil, nil
+	}
+	return ref, index, err
+}
+
+// manifestDigests adds the digests of the manifests referenced by the index, and by any index it references, to result
+func manifestDigests(index ggcrv1.ImageIndex, result map[string]bool) error {
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return err
+	}
+
+	for _, desc := range manifest.Manifests {
+		result[desc.Digest.String()] = true
+		if !desc.MediaType.IsIndex() {
+			continue
+		}
+		child, err := index.ImageIndex(desc.Digest)
+		if err != nil {
+			return err
+		}
+		if err := manifestDigests(child, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteManifest(ref name.Reference, opts ...remote.Option) error {
+	if err := remote.Delete(ref, opts...); !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,185 @@
+package imageprune
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func image(name, repo string, age time.Duration, now time.Time, tags ...string) v1.ImageInstance {
+	return v1.ImageInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "acorn",
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		},
+		Repo:   repo,
+		Digest: "sha256:" + name,
+		Tags:   tags,
+	}
+}
+
+func names(images []v1.ImageInstance) (result []string) {
+	for _, image := range images {
+		result = append(result, image.Name)
+	}
+	return
+}
+
+func TestCandidates(t *testing.T) {
+	now := time.Now()
+	images := []v1.ImageInstance{
+		image("aaaa", "", time.Hour, now),
+		image("bbbb", "", 2*time.Hour, now),
+		image("cccc", "", 3*time.Hour, now),
+		image("dddd", "", 4*time.Hour, now, "myimage:v1"),
+		image("eeee", "", 5*time.Hour, now),
+		image("ffff", "", 6*time.Hour, now),
+		image("1111", "ghcr.io/acorn/builds", 7*time.Hour, now),
+		image("2222", "ghcr.io/acorn/builds", 8*time.Hour, now),
+	}
+	apps := []v1.AppInstance{
+		{
+			Spec:   v1.AppInstanceSpec{Image: "eeee"},
+			Status: v1.AppInstanceStatus{AppImage: v1.AppImage{ID: "eeee"}},
+		},
+		{
+			Spec: v1.AppInstanceSpec{Image: "sha256:ccc"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   []string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:   "keep count",
+			policy: Policy{KeepCount: 1},
+			want:   []string{"2222", "bbbb", "ffff"},
+		},
+		{
+			name:   "max age",
+			policy: Policy{MaxAge: 150 * time.Minute},
+			want:   []string{"1111", "2222", "ffff"},
+		},
+		{
+			name:   "keep count and max age",
+			policy: Policy{KeepCount: 1, MaxAge: 7*time.Hour + 30*time.Minute},
+			want:   []string{"2222"},
+		},
+		{
+			name:   "keep count larger than images",
+			policy: Policy{KeepCount: 10},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, names(Candidates(images, apps, tt.policy, now)))
+		})
+	}
+}
+
+func TestCandidatesByTagRepository(t *testing.T) {
+	now := time.Now()
+	tagged := func(name, repo string, age time.Duration) v1.ImageInstance {
+		result := image(name, "", age, now)
+		result.Annotations = map[string]string{labels.AcornImageTagRepository: repo}
+		return result
+	}
+
+	images := []v1.ImageInstance{
+		tagged("aaaa", "web", time.Hour),
+		tagged("bbbb", "web", 2*time.Hour),
+		tagged("cccc", "api", 3*time.Hour),
+		tagged("dddd", "api", 4*time.Hour),
+		image("eeee", "", 5*time.Hour, now),
+	}
+
+	assert.Equal(t, []string{"bbbb", "dddd"}, names(Candidates(images, nil, Policy{KeepCount: 1}, now)))
+}
+
+func TestManifestDigests(t *testing.T) {
+	container, err := random.Index(16, 1, 2)
+	require.NoError(t, err)
+	app := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{
+		Add: container,
+		Descriptor: ggcrv1.Descriptor{
+			MediaType: types.OCIImageIndex,
+		},
+	})
+
+	want := map[string]bool{}
+	containerDigest, err := container.Digest()
+	require.NoError(t, err)
+	want[containerDigest.String()] = true
+
+	containerManifest, err := container.IndexManifest()
+	require.NoError(t, err)
+	for _, desc := range containerManifest.Manifests {
+		want[desc.Digest.String()] = true
+	}
+
+	got := map[string]bool{}
+	require.NoError(t, manifestDigests(app, got))
+	assert.Equal(t, want, got)
+	assert.Len(t, got, 3)
+}
+
+func TestBuilding(t *testing.T) {
+	now := time.Now()
+	build := func(transitioning bool, age time.Duration) v1.AcornImageBuildInstance {
+		return v1.AcornImageBuildInstance{
+			Status: v1.AcornImageBuildInstanceStatus{
+				Conditions: []v1.Condition{{
+					Type:               v1.AcornImageBuildInstanceConditionBuild,
+					Transitioning:      transitioning,
+					LastTransitionTime: metav1.NewTime(now.Add(-age)),
+				}},
+			},
+		}
+	}
+
+	assert.False(t, building(nil, now))
+	assert.False(t, building([]v1.AcornImageBuildInstance{build(false, time.Minute)}, now))
+	assert.True(t, building([]v1.AcornImageBuildInstance{build(false, time.Minute), build(true, time.Minute)}, now))
+	assert.False(t, building([]v1.AcornImageBuildInstance{build(true, 2*time.Hour)}, now))
+}
+
+func TestPolicyFromConfig(t *testing.T) {
+	count, maxAge := 3, "48h"
+	policy, err := PolicyFromConfig(&apiv1.Config{
+		ImageRetentionCount:  &count,
+		ImageRetentionMaxAge: &maxAge,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Policy{KeepCount: 3, MaxAge: 48 * time.Hour}, policy)
+	assert.True(t, policy.Enabled())
+
+	policy, err = PolicyFromConfig(&apiv1.Config{})
+	require.NoError(t, err)
+	assert.False(t, policy.Enabled())
+
+	invalid := "two days"
+	_, err = PolicyFromConfig(&apiv1.Config{ImageRetentionMaxAge: &invalid})
+	assert.Error(t, err)
+
+	negative := -1
+	_, err = PolicyFromConfig(&apiv1.Config{ImageRetentionCount: &negative})
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package imageprune
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imagesystem"
+	"github.com/acorn-io/acorn/pkg/system"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	pullDrainPeriod    = time.Minute
+	buildCheckInterval = 10 * time.Second
+	buildWaitTimeout   = 15 * time.Minute
+	buildTimeout       = time.Hour
+)
+
+// GarbageCollectRegistry runs the registry's blob garbage collection in each running internal registry pod, removing
+// blobs that are no longer referenced by any manifest. The registry is read-only while it is garbage collected, so
+// that blobs of concurrent pushes are not deleted. Untagged manifests are not deleted because images are pushed to
+// the internal registry by digest. The manifests of pruned images are deleted when they are pruned instead.
+func GarbageCollectRegistry(ctx context.Context, c kclient.Client, cfg *rest.Config) error {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, &kclient.ListOptions{
+		Namespace: system.ImagesNamespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			"app": system.RegistryName,
+		}),
+	}); err != nil {
+		return err
+	}
+
+	release, err := imagesystem.SetRegistryReadOnly(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := waitForPushes(ctx, c); err != nil {
+		return err
+	}
+
+	k8s, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		req := k8s.CoreV1().RESTClient().Post().
+			Namespace(pod.Namespace).
+			Resource("pods").
+			Name(pod.Name).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Container: "registry",
+				Command:   []string{"/usr/local/bin/registry", "garbage-collect", "/etc/docker/registry/config.yml"},
+				Stdout:    true,
+				Stderr:    true,
+			}, scheme.ParameterCodec)
+
+		exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+		if err != nil {
+			return err
+		}
+
+		stderr := &bytes.Buffer{}
+		if err := exec.Stream(remotecommand.StreamOptions{
+			Stdout: &bytes.Buffer{},
+			Stderr: stderr,
+		}); err != nil {
+			return fmt.Errorf("garbage collecting registry pod %s: %w: %s", pod.Name, err, stderr.String())
+		}
+	}
+
+	return nil
+}
+
+// waitForPushes waits for pushes to the internal registry that started before it became read-only. Pulls get a fixed
+// period to finish and builds are waited for until they are done.
+func waitForPushes(ctx context.Context, c kclient.Client) error {
+	select {
+	case <-time.After(pullDrainPeriod):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, buildWaitTimeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntilWithContext(ctx, buildCheckInterval, func(ctx context.Context) (bool, error) {
+		builds := &v1.AcornImageBuildInstanceList{}
+		if err := c.List(ctx, builds); err != nil {
+			return false, err
+		}
+		return !building(builds.Items, time.Now()), nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for builds to finish: %w", err)
+	}
+	return nil
+}
+
+// building returns true if any of the builds is running. Builds that have been running for longer than buildTimeout
+// are assumed to be abandoned.
+func building(builds []v1.AcornImageBuildInstance, now time.Time) bool {
+	for _, build := range builds {
+		for _, cond := range build.Status.Conditions {
+			if cond.Type == v1.AcornImageBuildInstanceConditionBuild && cond.Transitioning &&
+				now.Sub(cond.LastTransitionTime.Time) < buildTimeout {
+				return true
+			}
+		}
+	}
+	return false
+}
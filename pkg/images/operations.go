@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
@@ -14,6 +15,10 @@ import (
 	"github.com/google/go-containerregistry/pkg/authn"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -132,8 +137,13 @@ func pullIndex(tag imagename.Reference, opts []remote.Option) (*v1.AppImage, err
 // GetRuntimePullableImageReference is similar to GetImageReference but will return 127.0.0.1:NODEPORT instead of
 // registry.acorn-image-system.svc.cluster.local:5000, only use this method if you are passing the
 // image string to a PodSpec that will be pulled by the container runtime, otherwise use GetImageReference if you will
-// be pulling the image from the apiserver/controller
-func GetRuntimePullableImageReference(ctx context.Context, c client.Reader, namespace, image string) (imagename.Reference, error) {
+// be pulling the image from the apiserver/controller. If platforms is not empty, an error is returned if none of the
+// nodes of the cluster can run one of the platforms. Nodes are listed on every call, so pass a cached client.
+func GetRuntimePullableImageReference(ctx context.Context, c client.Reader, namespace, image string, platforms []v1.Platform) (imagename.Reference, error) {
+	if err := checkNodePlatforms(ctx, c, platforms); err != nil {
+		return nil, err
+	}
+
 	if tags.SHAPattern.MatchString(image) {
 		return imagesystem.GetRuntimePullableInternalRepoForNamespaceAndID(ctx, c, namespace, image)
 	}
@@ -169,3 +179,41 @@ func GetAuthenticationRemoteOptionsWithLocalAuth(ctx context.Context, registry a
 func GetAuthenticationRemoteOptions(ctx context.Context, client client.Reader, namespace string, additionalOpts ...remote.Option) ([]remote.Option, error) {
 	return GetAuthenticationRemoteOptionsWithLocalAuth(ctx, nil, nil, client, namespace, additionalOpts...)
 }
+
+func checkNodePlatforms(ctx context.Context, c client.Reader, platforms []v1.Platform) error {
+	if len(platforms) == 0 {
+		return nil
+	}
+
+	var nodes corev1.NodeList
+	if err := c.List(ctx, &nodes); meta.IsNoMatchError(err) || apierrors.IsForbidden(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var nodePlatforms []string
+	for _, node := range nodes.Items {
+		nodePlatform := v1.Platform{
+			OS:           node.Status.NodeInfo.OperatingSystem,
+			Architecture: node.Status.NodeInfo.Architecture,
+		}
+		if nodePlatform.OS == "" || nodePlatform.Architecture == "" || v1.HasPlatform(platforms, nodePlatform) {
+			return nil
+		}
+		if !slices.Contains(nodePlatforms, nodePlatform.String()) {
+			nodePlatforms = append(nodePlatforms, nodePlatform.String())
+		}
+	}
+
+	if len(nodePlatforms) == 0 {
+		return nil
+	}
+
+	imagePlatforms := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		imagePlatforms = append(imagePlatforms, platform.String())
+	}
+	return fmt.Errorf("image supports platforms [%s] but the cluster nodes are [%s]",
+		strings.Join(imagePlatforms, ", "), strings.Join(nodePlatforms, ", "))
+}
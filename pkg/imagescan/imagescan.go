@@ -0,0 +1,294 @@
+package imagescan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
+	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/tags"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const scanTimeout = 15 * time.Minute
+
+var (
+	ErrNoScanner   = errors.New("no vulnerability scanner is configured")
+	ErrScanPending = errors.New("the image is being scanned for vulnerabilities")
+
+	results = struct {
+		sync.Mutex
+		byKey map[scanKey]scanResult
+	}{byKey: map[scanKey]scanResult{}}
+)
+
+type scanKey struct {
+	scanner, digest string
+}
+
+type scanResult struct {
+	pending bool
+	status  *v1.ImageScanStatus
+	err     error
+}
+
+// FromConfig returns the scanner configured for the cluster, or ErrNoScanner if none is configured
+func FromConfig(ctx context.Context, c kclient.Reader) (Scanner, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner, err := New(*cfg.VulnerabilityScanner)
+	if err != nil {
+		return nil, err
+	} else if scanner == nil {
+		return nil, ErrNoScanner
+	}
+	return scanner, nil
+}
+
+// Threshold returns the strictest vulnerability threshold of the image policies that apply to the namespace and
+// the name of the policy that sets it. An empty threshold means images are not checked for vulnerabilities.
+func Threshold(ctx context.Context, c kclient.Reader, namespace string) (string, string, error) {
+	policies, err := imagepolicy.List(ctx, c, namespace)
+	if err != nil {
+		return "", "", err
+	}
+
+	var threshold, policyName string
+	for _, policy := range policies {
+		severity, err := ParseSeverity(policy.VulnerabilityThreshold)
+		if err != nil {
+			continue
+		}
+		if threshold == "" || rank(severity) < rank(threshold) {
+			threshold, policyName = severity, policy.Name
+		}
+	}
+	return threshold, policyName, nil
+}
+
+// CheckImage returns an error if any image policy that applies to the namespace has a vulnerability threshold and
+// the image has vulnerabilities at or above the threshold. The image may be a local image ID or a remote reference.
+// If digest is empty it is resolved from the image.
+//
+// The last scan of the image digest is reused. If the digest has not been scanned yet, a scan is started in the
+// background and an error wrapping ErrScanPending is returned, so the image can be checked again later.
+func CheckImage(ctx context.Context, c kclient.Client, namespace, image, digest string, opts ...remote.Option) error {
+	threshold, policyName, err := Threshold(ctx, c, namespace)
+	if err != nil || threshold == "" {
+		return err
+	}
+
+	scanner, err := FromConfig(ctx, c)
+	if errors.Is(err, ErrNoScanner) {
+		return fmt.Errorf("image policy %s requires a vulnerability scan but %w", policyName, err)
+	} else if err != nil {
+		return err
+	}
+
+	if digest == "" {
+		if localDigest, ok, err := tags.ResolveLocal(ctx, c, namespace, image); err != nil {
+			return err
+		} else if ok {
+			image, digest = localDigest, localDigest
+		} else if digest, err = images.ImageDigest(ctx, c, namespace, image, opts...); err != nil {
+			return err
+		}
+	}
+	if !strings.HasPrefix(digest, "sha256:") {
+		digest = "sha256:" + digest
+	}
+
+	status, err := lastStatus(ctx, c, scanner, namespace, digest)
+	if err != nil {
+		return err
+	} else if status == nil {
+		startScan(c, scanner, namespace, image, digest, opts)
+		return fmt.Errorf("image policy %s requires a vulnerability scan: %w", policyName, ErrScanPending)
+	}
+
+	if count := AtOrAbove(*status, threshold); count > 0 {
+		return fmt.Errorf("image has %d vulnerabilities with %s or higher severity, which are blocked by image policy %s",
+			count, strings.ToLower(threshold), policyName)
+	}
+	return nil
+}
+
+// lastStatus returns the result of the last scan of the digest by the scanner, or nil if it has not been scanned.
+// The result is recorded on the local image with the digest. Remote images have no local image, so their results are
+// kept in memory.
+func lastStatus(ctx context.Context, c kclient.Reader, scanner Scanner, namespace, digest string) (*v1.ImageScanStatus, error) {
+	image := &v1.ImageInstance{}
+	if err := c.Get(ctx, router.Key(namespace, strings.TrimPrefix(digest, "sha256:")), image); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	} else if err == nil && image.ScanStatus != nil && image.ScanStatus.Scanner == scanner.Name() && image.ScanStatus.Digest == digest {
+		return image.ScanStatus, nil
+	}
+
+	key := scanKey{scanner: scanner.Name(), digest: digest}
+	results.Lock()
+	defer results.Unlock()
+	result, ok := results.byKey[key]
+	if !ok || result.pending {
+		return nil, nil
+	}
+	if result.err != nil {
+		// Forget the failure so that the next check scans the image again
+		delete(results.byKey, key)
+		return nil, result.err
+	}
+	return result.status, nil
+}
+
+// startScan scans the image in the background unless a scan of the digest is already running
+func startScan(c kclient.Client, scanner Scanner, namespace, image, digest string, opts []remote.Option) {
+	key := scanKey{scanner: scanner.Name(), digest: digest}
+	results.Lock()
+	if result, ok := results.byKey[key]; ok && result.pending {
+		results.Unlock()
+		return
+	}
+	results.byKey[key] = scanResult{pending: true}
+	results.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), scanTimeout)
+		defer cancel()
+
+		status, _, err := scan(ctx, c, scanner, namespace, image, digest, opts...)
+		if err == nil {
+			err = recordStatus(ctx, c, namespace, strings.TrimPrefix(digest, "sha256:"), *status)
+			if apierrors.IsNotFound(err) {
+				err = nil
+			}
+		}
+		if err != nil {
+			logrus.Errorf("Failed to scan image %s for vulnerabilities: %v", image, err)
+		}
+
+		results.Lock()
+		results.byKey[key] = scanResult{status: status, err: err}
+		results.Unlock()
+	}()
+}
+
+// ScanImage scans an image with the configured scanner. If the image is a local image the result is recorded in its
+// scan status.
+func ScanImage(ctx context.Context, c kclient.Client, namespace, imageName string, opts ...remote.Option) (*apiv1.ImageScan, error) {
+	scanner, err := FromConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	imageName = strings.ReplaceAll(imageName, "+", "/")
+
+	var digest string
+	localImage := &apiv1.Image{}
+	if err := c.Get(ctx, router.Key(namespace, strings.ReplaceAll(imageName, "/", "+")), localImage); apierrors.IsNotFound(err) {
+		if tags.IsLocalReference(imageName) {
+			return nil, err
+		}
+		localImage = nil
+		digest, err = resolveDigest(ctx, c, namespace, imageName, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else {
+		imageName = localImage.Name
+		digest = localImage.Digest
+	}
+
+	status, vulnerabilities, err := scan(ctx, c, scanner, namespace, imageName, digest, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	results.Lock()
+	results.byKey[scanKey{scanner: scanner.Name(), digest: digest}] = scanResult{status: status}
+	results.Unlock()
+
+	if localImage != nil {
+		if err := recordStatus(ctx, c, localImage.Namespace, localImage.Name, *status); err != nil {
+			return nil, err
+		}
+	}
+
+	return &apiv1.ImageScan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      imageName,
+			Namespace: namespace,
+		},
+		Status:          *status,
+		Vulnerabilities: vulnerabilities,
+	}, nil
+}
+
+func scan(ctx context.Context, c kclient.Reader, scanner Scanner, namespace, image, digest string, opts ...remote.Option) (*v1.ImageScanStatus, []v1.Vulnerability, error) {
+	ref, err := images.GetImageReference(ctx, c, namespace, image)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vulnerabilities, err := scanner.Scan(ctx, ref.Context().Digest(digest), opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan image for vulnerabilities: %w", err)
+	}
+
+	status := Summarize(scanner.Name(), digest, vulnerabilities)
+	return &status, vulnerabilities, nil
+}
+
+func resolveDigest(ctx context.Context, c kclient.Reader, namespace, image string, opts ...remote.Option) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest.String(), nil
+}
+
+func recordStatus(ctx context.Context, c kclient.Client, namespace, name string, status v1.ImageScanStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		image := &v1.ImageInstance{}
+		if err := c.Get(ctx, router.Key(namespace, name), image); err != nil {
+			return err
+		}
+		image.ScanStatus = &status
+		return c.Update(ctx, image)
+	})
+}
@@ -0,0 +1,191 @@
+package imagescan
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testReport = `{
+  "SchemaVersion": 2,
+  "ArtifactName": "docker.io/library/app",
+  "Results": [
+    {
+      "Target": "app (alpine 3.17.2)",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2023-0001", "PkgName": "openssl", "InstalledVersion": "3.0.8-r0", "FixedVersion": "3.0.8-r1", "Severity": "CRITICAL", "Title": "openssl: bad things"},
+        {"VulnerabilityID": "CVE-2023-0002", "PkgName": "zlib", "InstalledVersion": "1.2.13-r0", "Severity": "medium"}
+      ]
+    },
+    {
+      "Target": "app/go.sum",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "GHSA-0003", "PkgName": "golang.org/x/net", "InstalledVersion": "0.1.0", "FixedVersion": "0.7.0", "Severity": "HIGH"},
+        {"VulnerabilityID": "GHSA-0004", "PkgName": "golang.org/x/text", "InstalledVersion": "0.3.0", "Severity": "UNKNOWN"}
+      ]
+    },
+    {
+      "Target": "app/config.yaml"
+    }
+  ]
+}`
+)
+
+func TestParseTrivyReport(t *testing.T) {
+	vulns, err := ParseTrivyReport([]byte(testReport))
+	require.NoError(t, err)
+	require.Len(t, vulns, 4)
+	assert.Equal(t, v1.Vulnerability{
+		ID:               "CVE-2023-0001",
+		Package:          "openssl",
+		InstalledVersion: "3.0.8-r0",
+		FixedVersion:     "3.0.8-r1",
+		Severity:         v1.SeverityCritical,
+		Title:            "openssl: bad things",
+	}, vulns[0])
+	assert.Equal(t, v1.SeverityMedium, vulns[1].Severity)
+
+	_, err = ParseTrivyReport([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestFileScan(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, testDigest[len("sha256:"):]+".json"), []byte(testReport), 0644))
+
+	scanner, err := New("file://" + dir)
+	require.NoError(t, err)
+
+	image, err := name.NewDigest("docker.io/library/app@" + testDigest)
+	require.NoError(t, err)
+
+	vulns, err := scanner.Scan(context.Background(), image)
+	require.NoError(t, err)
+
+	status := Summarize(scanner.Name(), testDigest, vulns)
+	assert.Equal(t, "file", status.Scanner)
+	assert.Equal(t, testDigest, status.Digest)
+	assert.Equal(t, 1, status.Critical)
+	assert.Equal(t, 1, status.High)
+	assert.Equal(t, 1, status.Medium)
+	assert.Equal(t, 0, status.Low)
+	assert.Equal(t, 1, status.Unknown)
+
+	missing, err := name.NewDigest("docker.io/library/app@sha256:" + "f123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+	_, err = scanner.Scan(context.Background(), missing)
+	assert.ErrorContains(t, err, "no vulnerability report found")
+}
+
+type countingScanner struct {
+	scans atomic.Int32
+}
+
+func (c *countingScanner) Name() string {
+	return "counting"
+}
+
+func (c *countingScanner) Scan(context.Context, name.Digest, ...remote.Option) ([]v1.Vulnerability, error) {
+	c.scans.Add(1)
+	return []v1.Vulnerability{{ID: "CVE-2023-0001", Severity: v1.SeverityHigh}}, nil
+}
+
+func TestLastStatus(t *testing.T) {
+	const remoteDigest = "sha256:f123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	results.Lock()
+	results.byKey = map[scanKey]scanResult{}
+	results.Unlock()
+
+	scanner := &countingScanner{}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&v1.ImageInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: testDigest[len("sha256:"):], Namespace: "acorn"},
+		Digest:     testDigest,
+		ScanStatus: &v1.ImageScanStatus{Scanner: scanner.Name(), Digest: testDigest, Critical: 1},
+	}).Build()
+
+	// The status recorded on the local image is reused
+	status, err := lastStatus(context.Background(), c, scanner, "acorn", testDigest)
+	require.NoError(t, err)
+	require.NotNil(t, status)
+	assert.Equal(t, 1, status.Critical)
+
+	// Remote images are scanned once in the background
+	status, err = lastStatus(context.Background(), c, scanner, "acorn", remoteDigest)
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	startScan(c, scanner, "acorn", "docker.io/library/app", remoteDigest, nil)
+	startScan(c, scanner, "acorn", "docker.io/library/app", remoteDigest, nil)
+	require.Eventually(t, func() bool {
+		status, err = lastStatus(context.Background(), c, scanner, "acorn", remoteDigest)
+		return err == nil && status != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, status.High)
+	assert.Equal(t, int32(1), scanner.scans.Load())
+}
+
+func TestNew(t *testing.T) {
+	scanner, err := New("")
+	assert.NoError(t, err)
+	assert.Nil(t, scanner)
+
+	scanner, err = New("trivy")
+	assert.NoError(t, err)
+	assert.Equal(t, "trivy", scanner.Name())
+
+	_, err = New("clair")
+	assert.Error(t, err)
+}
+
+func TestAtOrAbove(t *testing.T) {
+	status := v1.ImageScanStatus{
+		Critical: 1,
+		High:     2,
+		Medium:   3,
+		Low:      4,
+		Unknown:  5,
+	}
+
+	tests := []struct {
+		threshold string
+		want      int
+	}{
+		{threshold: v1.SeverityCritical, want: 1},
+		{threshold: v1.SeverityHigh, want: 3},
+		{threshold: v1.SeverityMedium, want: 6},
+		{threshold: v1.SeverityLow, want: 10},
+		{threshold: "high", want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.threshold, func(t *testing.T) {
+			assert.Equal(t, tt.want, AtOrAbove(status, tt.threshold))
+		})
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	severity, err := ParseSeverity("Critical")
+	assert.NoError(t, err)
+	assert.Equal(t, v1.SeverityCritical, severity)
+
+	_, err = ParseSeverity("unknown")
+	assert.Error(t, err)
+
+	_, err = ParseSeverity("severe")
+	assert.EqualError(t, err, `invalid severity "severe", must be one of critical, high, medium, or low`)
+}
@@ -0,0 +1,58 @@
+package imagescan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Scanner finds the known vulnerabilities of an image
+type Scanner interface {
+	// Name identifies the scanner in the scan status of an image
+	Name() string
+	// Scan returns the vulnerabilities of the image. The options provide the transport and credentials to pull the image.
+	Scan(ctx context.Context, image name.Digest, opts ...remote.Option) ([]v1.Vulnerability, error)
+}
+
+// New returns the scanner described by the vulnerabilityScanner config value, or nil if scanning is disabled.
+// Supported values are "trivy" and "file://<dir>".
+func New(scanner string) (Scanner, error) {
+	switch {
+	case scanner == "":
+		return nil, nil
+	case scanner == "trivy":
+		return &Trivy{Command: "trivy"}, nil
+	case strings.HasPrefix(scanner, "file://"):
+		return &File{Dir: strings.TrimPrefix(scanner, "file://")}, nil
+	}
+	return nil, fmt.Errorf("invalid vulnerability scanner %q, must be trivy or file://<dir>", scanner)
+}
+
+// Summarize counts the vulnerabilities by severity
+func Summarize(scanner, digest string, vulnerabilities []v1.Vulnerability) v1.ImageScanStatus {
+	status := v1.ImageScanStatus{
+		Scanner:   scanner,
+		Digest:    digest,
+		ScannedAt: metav1.Now(),
+	}
+	for _, vuln := range vulnerabilities {
+		switch strings.ToUpper(vuln.Severity) {
+		case v1.SeverityCritical:
+			status.Critical++
+		case v1.SeverityHigh:
+			status.High++
+		case v1.SeverityMedium:
+			status.Medium++
+		case v1.SeverityLow:
+			status.Low++
+		default:
+			status.Unknown++
+		}
+	}
+	return status
+}
@@ -0,0 +1,52 @@
+package imagescan
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+)
+
+var severities = []string{
+	v1.SeverityLow,
+	v1.SeverityMedium,
+	v1.SeverityHigh,
+	v1.SeverityCritical,
+}
+
+// ParseSeverity returns the normalized form of a vulnerability threshold
+func ParseSeverity(severity string) (string, error) {
+	severity = strings.ToUpper(severity)
+	if rank(severity) == 0 {
+		return "", fmt.Errorf("invalid severity %q, must be one of critical, high, medium, or low", strings.ToLower(severity))
+	}
+	return severity, nil
+}
+
+// rank orders the severities from 1 (low) to 4 (critical). Unknown severities are 0.
+func rank(severity string) int {
+	for i, s := range severities {
+		if s == severity {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AtOrAbove returns the number of vulnerabilities in the status with a severity at or above the threshold
+func AtOrAbove(status v1.ImageScanStatus, threshold string) int {
+	counts := map[string]int{
+		v1.SeverityLow:      status.Low,
+		v1.SeverityMedium:   status.Medium,
+		v1.SeverityHigh:     status.High,
+		v1.SeverityCritical: status.Critical,
+	}
+
+	var result int
+	for _, severity := range severities {
+		if rank(severity) >= rank(strings.ToUpper(threshold)) {
+			result += counts[severity]
+		}
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package imagescan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+type trivyReport struct {
+	Results []struct {
+		Target          string `json:"Target"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// ParseTrivyReport reads the vulnerabilities from the output of "trivy image --format json"
+func ParseTrivyReport(data []byte) ([]v1.Vulnerability, error) {
+	report := trivyReport{}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("invalid trivy report: %w", err)
+	}
+
+	var result []v1.Vulnerability
+	for _, target := range report.Results {
+		for _, vuln := range target.Vulnerabilities {
+			result = append(result, v1.Vulnerability{
+				ID:               vuln.VulnerabilityID,
+				Package:          vuln.PkgName,
+				InstalledVersion: vuln.InstalledVersion,
+				FixedVersion:     vuln.FixedVersion,
+				Severity:         strings.ToUpper(vuln.Severity),
+				Title:            vuln.Title,
+			})
+		}
+	}
+	return result, nil
+}
+
+// Trivy scans images by running the trivy CLI. The image is pulled by acorn and passed to trivy as a tarball, because
+// trivy can't reach the internal registry or use the registry credentials of the project on its own.
+type Trivy struct {
+	Command string
+}
+
+func (t *Trivy) Name() string {
+	return "trivy"
+}
+
+func (t *Trivy) Scan(ctx context.Context, image name.Digest, opts ...remote.Option) ([]v1.Vulnerability, error) {
+	img, err := remote.Image(image, append(opts, remote.WithContext(ctx))...)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "acorn-scan-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "image.tar")
+	if err := tarball.WriteToFile(input, image, img); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, t.Command, "image", "--quiet", "--format", "json", "--input", input)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running %s: %w: %s", t.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return ParseTrivyReport(stdout.Bytes())
+}
@@ -0,0 +1,139 @@
+package imagesignature
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	cosignPrivateKeyPemType   = "ENCRYPTED COSIGN PRIVATE KEY"
+	sigstorePrivateKeyPemType = "ENCRYPTED SIGSTORE PRIVATE KEY"
+)
+
+// encryptedKey is the format cosign uses to store password protected private keys
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// LoadPrivateKey parses a PEM encoded private key. Both unencrypted PKCS8, EC and PKCS1 keys and password protected
+// keys generated by cosign are supported. passFunc is only called if the key is encrypted.
+func LoadPrivateKey(data []byte, passFunc func() ([]byte, error)) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid private key: no PEM block found")
+	}
+
+	der := block.Bytes
+	switch block.Type {
+	case cosignPrivateKeyPemType, sigstorePrivateKeyPemType:
+		if passFunc == nil {
+			return nil, errors.New("private key is encrypted and no password was provided")
+		}
+		pass, err := passFunc()
+		if err != nil {
+			return nil, err
+		}
+		der, err = decrypt(block.Bytes, pass)
+		if err != nil {
+			return nil, err
+		}
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func decrypt(data, pass []byte) ([]byte, error) {
+	key := encryptedKey{}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid encrypted private key: %w", err)
+	}
+	if key.KDF.Name != "scrypt" || key.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("unsupported private key encryption %s/%s", key.KDF.Name, key.Cipher.Name)
+	}
+	if len(key.Cipher.Nonce) != 24 {
+		return nil, errors.New("invalid encrypted private key: bad nonce length")
+	}
+
+	derived, err := scrypt.Key(pass, key.KDF.Salt, key.KDF.Params.N, key.KDF.Params.R, key.KDF.Params.P, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		secret [32]byte
+		nonce  [24]byte
+	)
+	copy(secret[:], derived)
+	copy(nonce[:], key.Cipher.Nonce)
+
+	plaintext, ok := secretbox.Open(nil, key.Ciphertext, &nonce, &secret)
+	if !ok {
+		return nil, errors.New("failed to decrypt private key: incorrect password")
+	}
+	return plaintext, nil
+}
+
+// ParsePublicKey parses a PEM encoded PKIX public key
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid public key: no PEM block found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return key, nil
+}
+
+// ParsePublicKeys parses a list of PEM encoded PKIX public keys
+func ParsePublicKeys(keys []string) (result []crypto.PublicKey, _ error) {
+	for _, key := range keys {
+		pub, err := ParsePublicKey([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, pub)
+	}
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package imagesignature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+func encodePrivateKey(t *testing.T, key crypto.Signer) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func encryptPrivateKey(t *testing.T, key crypto.Signer, pass []byte) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	var (
+		secret [32]byte
+		nonce  [24]byte
+		salt   = make([]byte, 32)
+	)
+	_, err = rand.Read(salt)
+	require.NoError(t, err)
+	_, err = rand.Read(nonce[:])
+	require.NoError(t, err)
+
+	derived, err := scrypt.Key(pass, salt, 1024, 8, 1, 32)
+	require.NoError(t, err)
+	copy(secret[:], derived)
+
+	encrypted := encryptedKey{}
+	encrypted.KDF.Name = "scrypt"
+	encrypted.KDF.Params.N = 1024
+	encrypted.KDF.Params.R = 8
+	encrypted.KDF.Params.P = 1
+	encrypted.KDF.Salt = salt
+	encrypted.Cipher.Name = "nacl/secretbox"
+	encrypted.Cipher.Nonce = nonce[:]
+	encrypted.Ciphertext = secretbox.Seal(nil, der, &nonce, &secret)
+
+	data, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: cosignPrivateKeyPemType, Bytes: data})
+}
+
+func TestSignAndVerifyKeyTypes(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload, err := NewPayload("ghcr.io/acorn-io/test", "sha256:3b0c7e1f7a1a4b5b8f1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a")
+	require.NoError(t, err)
+
+	for _, key := range []crypto.Signer{ecKey, rsaKey, edKey} {
+		signer, err := LoadPrivateKey(encodePrivateKey(t, key), nil)
+		require.NoError(t, err)
+
+		pub, err := ParsePublicKey(encodePublicKey(t, key.Public()))
+		require.NoError(t, err)
+
+		sig, err := Sign(signer, payload)
+		require.NoError(t, err)
+
+		assert.NoError(t, VerifySignature(pub, payload, sig))
+		assert.ErrorIs(t, VerifySignature(pub, append(payload, ' '), sig), ErrInvalidSignature)
+	}
+}
+
+func TestLoadEncryptedPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	data := encryptPrivateKey(t, key, []byte("password"))
+
+	_, err = LoadPrivateKey(data, nil)
+	assert.Error(t, err)
+
+	_, err = LoadPrivateKey(data, func() ([]byte, error) {
+		return []byte("wrong"), nil
+	})
+	assert.ErrorContains(t, err, "incorrect password")
+
+	signer, err := LoadPrivateKey(data, func() ([]byte, error) {
+		return []byte("password"), nil
+	})
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+}
+
+func TestParsePayload(t *testing.T) {
+	payload, err := NewPayload("ghcr.io/acorn-io/test", "sha256:abc")
+	require.NoError(t, err)
+
+	parsed, err := ParsePayload(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io/acorn-io/test", parsed.Critical.Identity.DockerReference)
+	assert.Equal(t, "sha256:abc", parsed.Critical.Image.DockerManifestDigest)
+
+	_, err = ParsePayload([]byte(`{"critical":{"type":"something else"}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package imagesignature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
+	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PublicKeys returns all the public keys configured by the project and cluster image policies of the namespace
+func PublicKeys(ctx context.Context, c kclient.Reader, namespace string) ([]string, error) {
+	policies, err := imagepolicy.List(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, policy := range policies {
+		result = append(result, policy.PublicKeys...)
+	}
+	return result, nil
+}
+
+// VerifyImage ensures that image is signed by one of the public keys configured by the image policies that apply to
+// the namespace. The image may be a local image ID or a remote reference. If no public keys are configured all images
+// are allowed.
+func VerifyImage(ctx context.Context, c kclient.Reader, namespace, image, digest string, opts ...remote.Option) error {
+	keys, err := PublicKeys(ctx, c, namespace)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	publicKeys, err := ParsePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	ref, err := images.GetImageReference(ctx, c, namespace, image)
+	if err != nil {
+		return err
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return err
+	}
+
+	err = Verify(ref.Context(), digest, publicKeys, opts...)
+	if errors.Is(err, ErrNoSignature) {
+		return errors.New("image is not signed and the image policy requires a signature from a trusted key")
+	} else if errors.Is(err, ErrInvalidSignature) {
+		return errors.New("image has no signature that verifies against a trusted key of the image policy")
+	} else if err != nil {
+		return fmt.Errorf("failed to verify image signature: %w", err)
+	}
+	return nil
+}
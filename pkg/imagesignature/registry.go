@@ -0,0 +1,213 @@
+package imagesignature
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	SignatureMediaType  = types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json")
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+var (
+	ErrNoSignature      = errors.New("no signatures found")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Signature is a signature of an image along with the payload that was signed
+type Signature struct {
+	Payload   []byte
+	Signature []byte
+}
+
+// SignatureTag returns the tag the signatures for the image digest are stored at in the given repository
+func SignatureTag(repo name.Repository, digest string) (name.Tag, error) {
+	h, err := ggcrv1.NewHash(digest)
+	if err != nil {
+		return name.Tag{}, err
+	}
+	return repo.Tag(fmt.Sprintf("%s-%s.sig", h.Algorithm, h.Hex)), nil
+}
+
+// Signatures returns all the signatures for the image digest stored in the given repository. ErrNoSignature is
+// returned if no signatures exist.
+func Signatures(repo name.Repository, digest string, opts ...remote.Option) ([]Signature, error) {
+	img, err := signatureImage(repo, digest, opts...)
+	if err != nil {
+		return nil, err
+	} else if img == nil {
+		return nil, ErrNoSignature
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Signature
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != SignatureMediaType {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(layer.Annotations[SignatureAnnotation])
+		if err != nil {
+			continue
+		}
+		l, err := img.LayerByDigest(layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := readLayer(l)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, Signature{
+			Payload:   payload,
+			Signature: sig,
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, ErrNoSignature
+	}
+	return result, nil
+}
+
+// Append adds a signature for the image digest to the given repository, keeping any existing signatures
+func Append(repo name.Repository, digest string, sig Signature, opts ...remote.Option) error {
+	tag, err := SignatureTag(repo, digest)
+	if err != nil {
+		return err
+	}
+
+	img, err := signatureImage(repo, digest, opts...)
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(sig.Signature)
+	if img == nil {
+		img = mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), types.OCIConfigJSON)
+	} else {
+		manifest, err := img.Manifest()
+		if err != nil {
+			return err
+		}
+		for _, layer := range manifest.Layers {
+			if layer.Annotations[SignatureAnnotation] == encoded {
+				return nil
+			}
+		}
+	}
+
+	img, err = mutate.Append(img, mutate.Addendum{
+		Layer: static.NewLayer(sig.Payload, SignatureMediaType),
+		Annotations: map[string]string{
+			SignatureAnnotation: encoded,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(tag, img, opts...)
+}
+
+// Copy copies all the signatures for the image digest from one repository to another. If there are no
+// signatures to copy this is a no-op.
+func Copy(from, to name.Repository, digest string, opts ...remote.Option) error {
+	img, err := signatureImage(from, digest, opts...)
+	if err != nil || img == nil {
+		return err
+	}
+
+	tag, err := SignatureTag(to, digest)
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(tag, img, opts...)
+}
+
+// Verify checks that the image digest in the given repository has at least one signature that is valid for one
+// of the given public keys.
+func Verify(repo name.Repository, digest string, keys []crypto.PublicKey, opts ...remote.Option) error {
+	sigs, err := Signatures(repo, digest, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		payload, err := ParsePayload(sig.Payload)
+		if err != nil {
+			continue
+		}
+		if payload.Critical.Image.DockerManifestDigest != digest {
+			continue
+		}
+		for _, key := range keys {
+			if err := VerifySignature(key, sig.Payload, sig.Signature); err == nil {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func signatureImage(repo name.Repository, digest string, opts ...remote.Option) (ggcrv1.Image, error) {
+	tag, err := SignatureTag(repo, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := remote.Image(tag, opts...)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return img, err
+}
+
+func readLayer(layer ggcrv1.Layer) ([]byte, error) {
+	reader, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	buf := &bytes.Buffer{}
+	if _, err := io.Copy(buf, reader); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		if terr.StatusCode == http.StatusNotFound {
+			return true
+		}
+		for _, diag := range terr.Errors {
+			if diag.Code == transport.ManifestUnknownErrorCode || diag.Code == transport.NameUnknownErrorCode {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package imagesignature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistry(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+func pushIndex(t *testing.T, repo name.Repository) string {
+	t.Helper()
+	index, err := random.Index(64, 1, 1)
+	require.NoError(t, err)
+
+	digest, err := index.Digest()
+	require.NoError(t, err)
+
+	require.NoError(t, remote.WriteIndex(repo.Digest(digest.String()), index))
+	return digest.String()
+}
+
+func sign(t *testing.T, key crypto.Signer, repo name.Repository, digest string) Signature {
+	t.Helper()
+	payload, err := NewPayload(repo.String(), digest)
+	require.NoError(t, err)
+
+	sig, err := Sign(key, payload)
+	require.NoError(t, err)
+
+	return Signature{
+		Payload:   payload,
+		Signature: sig,
+	}
+}
+
+func TestAppendAndVerify(t *testing.T) {
+	host := newRegistry(t)
+	repo, err := name.NewRepository(host + "/test/app")
+	require.NoError(t, err)
+
+	trusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	untrusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	digest := pushIndex(t, repo)
+	otherDigest := pushIndex(t, repo)
+
+	assert.ErrorIs(t, Verify(repo, digest, []crypto.PublicKey{trusted.Public()}), ErrNoSignature)
+
+	require.NoError(t, Append(repo, digest, sign(t, untrusted, repo, digest)))
+	assert.ErrorIs(t, Verify(repo, digest, []crypto.PublicKey{trusted.Public()}), ErrInvalidSignature)
+
+	// A valid signature of another image must not be accepted
+	require.NoError(t, Append(repo, digest, sign(t, trusted, repo, otherDigest)))
+	assert.ErrorIs(t, Verify(repo, digest, []crypto.PublicKey{trusted.Public()}), ErrInvalidSignature)
+
+	sig := sign(t, trusted, repo, digest)
+	require.NoError(t, Append(repo, digest, sig))
+	require.NoError(t, Append(repo, digest, sig))
+	assert.NoError(t, Verify(repo, digest, []crypto.PublicKey{untrusted.Public(), trusted.Public()}))
+
+	sigs, err := Signatures(repo, digest)
+	require.NoError(t, err)
+	assert.Len(t, sigs, 3)
+
+	assert.ErrorIs(t, Verify(repo, otherDigest, []crypto.PublicKey{trusted.Public()}), ErrNoSignature)
+}
+
+func TestCopy(t *testing.T) {
+	host := newRegistry(t)
+	from, err := name.NewRepository(host + "/test/from")
+	require.NoError(t, err)
+	to, err := name.NewRepository(host + "/test/to")
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	digest := pushIndex(t, from)
+
+	// Nothing to copy is not an error
+	require.NoError(t, Copy(from, to, digest))
+	assert.ErrorIs(t, Verify(to, digest, []crypto.PublicKey{key.Public()}), ErrNoSignature)
+
+	require.NoError(t, Append(from, digest, sign(t, key, from, digest)))
+	require.NoError(t, Copy(from, to, digest))
+	assert.NoError(t, Verify(to, digest, []crypto.PublicKey{key.Public()}))
+}
@@ -0,0 +1,98 @@
+package imagesignature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	payloadType = "cosign container image signature"
+)
+
+// Payload is the simple signing payload that is signed. The format is compatible with cosign.
+type Payload struct {
+	Critical Critical       `json:"critical"`
+	Optional map[string]any `json:"optional"`
+}
+
+type Critical struct {
+	Identity Identity `json:"identity"`
+	Image    Image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type Identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type Image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// NewPayload returns the payload to sign for the image with the given repository and digest
+func NewPayload(repo, digest string) ([]byte, error) {
+	return json.Marshal(Payload{
+		Critical: Critical{
+			Identity: Identity{
+				DockerReference: repo,
+			},
+			Image: Image{
+				DockerManifestDigest: digest,
+			},
+			Type: payloadType,
+		},
+	})
+}
+
+// ParsePayload parses the payload and ensures it is a simple signing payload
+func ParsePayload(data []byte) (*Payload, error) {
+	payload := &Payload{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if payload.Critical.Type != payloadType {
+		return nil, fmt.Errorf("invalid signature payload type [%s]", payload.Critical.Type)
+	}
+	if payload.Critical.Image.DockerManifestDigest == "" {
+		return nil, errors.New("invalid signature payload: missing image digest")
+	}
+	return payload, nil
+}
+
+// Sign signs the payload with the given private key
+func Sign(signer crypto.Signer, payload []byte) ([]byte, error) {
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		return signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+	digest := sha256.Sum256(payload)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// VerifySignature checks that the signature of the payload is valid for the given public key
+func VerifySignature(key crypto.PublicKey, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return ErrInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, signature) {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+	return nil
+}
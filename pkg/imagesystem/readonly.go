@@ -0,0 +1,78 @@
+package imagesystem
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/acorn-io/acorn/pkg/system"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	RegistryGCLeaseName = "registry-gc"
+	// registryReadOnlyTimeout bounds how long the internal registry stays read-only if the garbage collection never
+	// makes it writable again, for example because the controller exited
+	registryReadOnlyTimeout = 30 * time.Minute
+)
+
+var ErrRegistryReadOnly = errors.New("the internal registry is being garbage collected, try again later")
+
+// CheckRegistryWritable returns ErrRegistryReadOnly while the internal registry is garbage collected. Anything that
+// pushes to the internal registry must check it first, because blobs pushed during a garbage collection can be
+// deleted before the manifest that references them is written.
+func CheckRegistryWritable(ctx context.Context, c client.Reader) error {
+	lease := &coordinationv1.Lease{}
+	if err := c.Get(ctx, router.Key(system.ImagesNamespace, RegistryGCLeaseName), lease); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if lease.Spec.RenewTime != nil && time.Since(lease.Spec.RenewTime.Time) > registryReadOnlyTimeout {
+		return nil
+	}
+	return ErrRegistryReadOnly
+}
+
+// SetRegistryReadOnly makes the internal registry read-only until the returned function is called. Pushes that
+// started before are not interrupted, so the caller has to wait for them to finish.
+func SetRegistryReadOnly(ctx context.Context, c client.Client) (func(), error) {
+	now := metav1.NowMicro()
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RegistryGCLeaseName,
+			Namespace: system.ImagesNamespace,
+		},
+		Spec: coordinationv1.LeaseSpec{
+			LeaseDurationSeconds: &[]int32{int32(registryReadOnlyTimeout / time.Second)}[0],
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+
+	err := c.Create(ctx, lease)
+	if apierrors.IsAlreadyExists(err) {
+		// The previous garbage collection didn't make the registry writable again
+		existing := &coordinationv1.Lease{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(lease), existing); err != nil {
+			return nil, err
+		}
+		existing.Spec = lease.Spec
+		err = c.Update(ctx, existing)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := c.Delete(context.Background(), lease); err != nil && !apierrors.IsNotFound(err) {
+			logrus.Errorf("Failed to make the internal registry writable: %v", err)
+		}
+	}, nil
+}
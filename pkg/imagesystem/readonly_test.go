@@ -0,0 +1,49 @@
+package imagesystem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/acorn-io/acorn/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRegistryReadOnly(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	assert.NoError(t, CheckRegistryWritable(ctx, c))
+
+	release, err := SetRegistryReadOnly(ctx, c)
+	require.NoError(t, err)
+	assert.ErrorIs(t, CheckRegistryWritable(ctx, c), ErrRegistryReadOnly)
+
+	// A registry left read-only by an earlier garbage collection is taken over
+	_, err = SetRegistryReadOnly(ctx, c)
+	require.NoError(t, err)
+	assert.ErrorIs(t, CheckRegistryWritable(ctx, c), ErrRegistryReadOnly)
+
+	release()
+	assert.NoError(t, CheckRegistryWritable(ctx, c))
+}
+
+func TestRegistryReadOnlyExpired(t *testing.T) {
+	renewed := metav1.NewMicroTime(time.Now().Add(-2 * registryReadOnlyTimeout))
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RegistryGCLeaseName,
+			Namespace: system.ImagesNamespace,
+		},
+		Spec: coordinationv1.LeaseSpec{
+			RenewTime: &renewed,
+		},
+	}).Build()
+
+	assert.NoError(t, CheckRegistryWritable(context.Background(), c))
+}
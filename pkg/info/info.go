@@ -15,7 +15,9 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Get(ctx context.Context, c kclient.Reader) (*apiv1.Info, error) {
+// Get returns the info of the installation. If namespace is set, the config is the config for the apps in the
+// namespace, with the overrides of its project applied.
+func Get(ctx context.Context, c kclient.Reader, namespace string) (*apiv1.Info, error) {
 	var controllerImage string
 	var apiServerImage string
 
@@ -40,7 +42,12 @@ func Get(ctx context.Context, c kclient.Reader) (*apiv1.Info, error) {
 		return nil, err
 	}
 
-	cfg, err := config.Get(ctx, c)
+	cfg, err := config.GetForProject(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	projectConfig, err := config.ProjectConfig(ctx, c, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +80,7 @@ func Get(ctx context.Context, c kclient.Reader) (*apiv1.Info, error) {
 			APIServerImage:         apiServerImage,
 			Config:                 *cfg,
 			UserConfig:             *raw,
+			ProjectConfig:          projectConfig,
 			LetsEncryptCertificate: letsEncryptCert,
 		},
 	}, nil
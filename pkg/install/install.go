@@ -15,6 +15,8 @@ import (
 	"github.com/acorn-io/acorn/pkg/autoupgrade/validate"
 	"github.com/acorn-io/acorn/pkg/buildserver"
 	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/controller/tls"
+	"github.com/acorn-io/acorn/pkg/imageprune"
 	"github.com/acorn-io/acorn/pkg/install/progress"
 	"github.com/acorn-io/acorn/pkg/k8sclient"
 	labels2 "github.com/acorn-io/acorn/pkg/labels"
@@ -114,6 +116,18 @@ func Install(ctx context.Context, image string, opts *Options) error {
 		return err
 	}
 
+	if err := imageprune.ValidateConfig(finalConfForValidation); err != nil {
+		return err
+	}
+
+	if err := tls.ValidateConfig(finalConfForValidation); err != nil {
+		return err
+	}
+
+	if err := config.ValidateLockedProjectConfig(finalConfForValidation); err != nil {
+		return err
+	}
+
 	// Require E-Mail address when using Let's Encrypt production
 	if *finalConfForValidation.LetsEncrypt == "enabled" {
 		if !*finalConfForValidation.LetsEncryptTOSAgree {
@@ -49,6 +49,10 @@ const (
 	AcornLetsEncryptSettingsHash = Prefix + "le-hash"
 	AcornProject                 = Prefix + "project"
 	AcornProjectName             = Prefix + "project-name"
+	AcornProjectSpec             = Prefix + "project-spec"
+	AcornProjectMembership       = Prefix + "project-membership"
+	AcornUpgradeCheckRequested   = Prefix + "upgrade-check-requested"
+	AcornImageTagRepository      = Prefix + "image-tag-repository"
 )
 
 func Merge(base, overlay map[string]string) map[string]string {
@@ -29,6 +29,11 @@ func nextColor() pterm.Color {
 }
 
 func Output(ctx context.Context, c client.Client, name string, opts *client.LogOptions) error {
+	return OutputWithPrefix(ctx, c, name, "", opts)
+}
+
+// OutputWithPrefix prints the logs of the app like Output, with the prefix prepended to the container names
+func OutputWithPrefix(ctx context.Context, c client.Client, name, prefix string, opts *client.LogOptions) error {
 	msgs, err := c.AppLog(ctx, name, opts)
 	if err != nil {
 		return err
@@ -49,7 +54,7 @@ func Output(ctx context.Context, c client.Client, name string, opts *client.LogO
 					containerColors[msg.ContainerName] = color
 				}
 
-				pterm.Printf("%s: %s\n", color.Sprint(msg.ContainerName), msg.Line)
+				pterm.Printf("%s: %s\n", color.Sprint(prefix+msg.ContainerName), msg.Line)
 			} else if !strings.Contains(msg.Error, "context canceled") {
 				logrus.Error(msg.Error)
 			}
@@ -244,6 +244,21 @@ func (mr *MockClientMockRecorder) AppUpdate(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppUpdate", reflect.TypeOf((*MockClient)(nil).AppUpdate), arg0, arg1, arg2)
 }
 
+// AuditEntryList mocks base method
+func (m *MockClient) AuditEntryList(arg0 context.Context) ([]v1.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEntryList", arg0)
+	ret0, _ := ret[0].([]v1.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditEntryList indicates an expected call of AuditEntryList
+func (mr *MockClientMockRecorder) AuditEntryList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEntryList", reflect.TypeOf((*MockClient)(nil).AuditEntryList), arg0)
+}
+
 // ComputeClassGet mocks base method
 func (m *MockClient) ComputeClassGet(arg0 context.Context, arg1 string) (*v1.ComputeClass, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +349,21 @@ func (mr *MockClientMockRecorder) ContainerReplicaList(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerReplicaList", reflect.TypeOf((*MockClient)(nil).ContainerReplicaList), arg0, arg1)
 }
 
+// ContainerReplicaPortForward mocks base method
+func (m *MockClient) ContainerReplicaPortForward(arg0 context.Context, arg1 string, arg2 int) (client.PortForwardDialer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerReplicaPortForward", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.PortForwardDialer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerReplicaPortForward indicates an expected call of ContainerReplicaPortForward
+func (mr *MockClientMockRecorder) ContainerReplicaPortForward(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerReplicaPortForward", reflect.TypeOf((*MockClient)(nil).ContainerReplicaPortForward), arg0, arg1, arg2)
+}
+
 // CredentialCreate mocks base method
 func (m *MockClient) CredentialCreate(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool) (*v1.Credential, error) {
 	m.ctrl.T.Helper()
@@ -512,6 +542,51 @@ func (mr *MockClientMockRecorder) ImageList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageList", reflect.TypeOf((*MockClient)(nil).ImageList), arg0)
 }
 
+// ImagePolicyGet mocks base method
+func (m *MockClient) ImagePolicyGet(arg0 context.Context, arg1 string) (*v1.ImagePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePolicyGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ImagePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePolicyGet indicates an expected call of ImagePolicyGet
+func (mr *MockClientMockRecorder) ImagePolicyGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePolicyGet", reflect.TypeOf((*MockClient)(nil).ImagePolicyGet), arg0, arg1)
+}
+
+// ImagePolicyList mocks base method
+func (m *MockClient) ImagePolicyList(arg0 context.Context) ([]v1.ImagePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePolicyList", arg0)
+	ret0, _ := ret[0].([]v1.ImagePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePolicyList indicates an expected call of ImagePolicyList
+func (mr *MockClientMockRecorder) ImagePolicyList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePolicyList", reflect.TypeOf((*MockClient)(nil).ImagePolicyList), arg0)
+}
+
+// ImagePrune mocks base method
+func (m *MockClient) ImagePrune(arg0 context.Context, arg1 *client.ImagePruneOptions) (*v1.ImagePrune, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePrune", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ImagePrune)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePrune indicates an expected call of ImagePrune
+func (mr *MockClientMockRecorder) ImagePrune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePrune", reflect.TypeOf((*MockClient)(nil).ImagePrune), arg0, arg1)
+}
+
 // ImagePull mocks base method
 func (m *MockClient) ImagePull(arg0 context.Context, arg1 string, arg2 *client.ImagePullOptions) (<-chan client.ImageProgress, error) {
 	m.ctrl.T.Helper()
@@ -542,6 +617,36 @@ func (mr *MockClientMockRecorder) ImagePush(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePush", reflect.TypeOf((*MockClient)(nil).ImagePush), arg0, arg1, arg2)
 }
 
+// ImageScan mocks base method
+func (m *MockClient) ImageScan(arg0 context.Context, arg1 string) (*v1.ImageScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScan", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ImageScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScan indicates an expected call of ImageScan
+func (mr *MockClientMockRecorder) ImageScan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScan", reflect.TypeOf((*MockClient)(nil).ImageScan), arg0, arg1)
+}
+
+// ImageSign mocks base method
+func (m *MockClient) ImageSign(arg0 context.Context, arg1 string, arg2 *client.ImageSignOptions) (*v1.ImageSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSign", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ImageSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSign indicates an expected call of ImageSign
+func (mr *MockClientMockRecorder) ImageSign(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSign", reflect.TypeOf((*MockClient)(nil).ImageSign), arg0, arg1, arg2)
+}
+
 // ImageTag mocks base method
 func (m *MockClient) ImageTag(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -631,6 +736,66 @@ func (mr *MockClientMockRecorder) ProjectList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectList", reflect.TypeOf((*MockClient)(nil).ProjectList), arg0)
 }
 
+// ProjectMembershipAdd mocks base method
+func (m *MockClient) ProjectMembershipAdd(arg0 context.Context, arg1, arg2 string, arg3 []string) (*v1.ProjectMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectMembershipAdd", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.ProjectMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectMembershipAdd indicates an expected call of ProjectMembershipAdd
+func (mr *MockClientMockRecorder) ProjectMembershipAdd(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectMembershipAdd", reflect.TypeOf((*MockClient)(nil).ProjectMembershipAdd), arg0, arg1, arg2, arg3)
+}
+
+// ProjectMembershipList mocks base method
+func (m *MockClient) ProjectMembershipList(arg0 context.Context) ([]v1.ProjectMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectMembershipList", arg0)
+	ret0, _ := ret[0].([]v1.ProjectMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectMembershipList indicates an expected call of ProjectMembershipList
+func (mr *MockClientMockRecorder) ProjectMembershipList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectMembershipList", reflect.TypeOf((*MockClient)(nil).ProjectMembershipList), arg0)
+}
+
+// ProjectMembershipRemove mocks base method
+func (m *MockClient) ProjectMembershipRemove(arg0 context.Context, arg1, arg2 string) (*v1.ProjectMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectMembershipRemove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ProjectMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectMembershipRemove indicates an expected call of ProjectMembershipRemove
+func (mr *MockClientMockRecorder) ProjectMembershipRemove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectMembershipRemove", reflect.TypeOf((*MockClient)(nil).ProjectMembershipRemove), arg0, arg1, arg2)
+}
+
+// ProjectUpdate mocks base method
+func (m *MockClient) ProjectUpdate(arg0 context.Context, arg1 *v1.Project) (*v1.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectUpdate indicates an expected call of ProjectUpdate
+func (mr *MockClientMockRecorder) ProjectUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectUpdate", reflect.TypeOf((*MockClient)(nil).ProjectUpdate), arg0, arg1)
+}
+
 // SecretCreate mocks base method
 func (m *MockClient) SecretCreate(arg0 context.Context, arg1, arg2 string, arg3 map[string][]byte) (*v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -721,6 +886,66 @@ func (mr *MockClientMockRecorder) SecretUpdate(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretUpdate", reflect.TypeOf((*MockClient)(nil).SecretUpdate), arg0, arg1, arg2)
 }
 
+// UpgradeWebhookCreate mocks base method
+func (m *MockClient) UpgradeWebhookCreate(arg0 context.Context, arg1 string, arg2 v10.UpgradeWebhookSpec) (*v1.UpgradeWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeWebhookCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.UpgradeWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeWebhookCreate indicates an expected call of UpgradeWebhookCreate
+func (mr *MockClientMockRecorder) UpgradeWebhookCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeWebhookCreate", reflect.TypeOf((*MockClient)(nil).UpgradeWebhookCreate), arg0, arg1, arg2)
+}
+
+// UpgradeWebhookDelete mocks base method
+func (m *MockClient) UpgradeWebhookDelete(arg0 context.Context, arg1 string) (*v1.UpgradeWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeWebhookDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.UpgradeWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeWebhookDelete indicates an expected call of UpgradeWebhookDelete
+func (mr *MockClientMockRecorder) UpgradeWebhookDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeWebhookDelete", reflect.TypeOf((*MockClient)(nil).UpgradeWebhookDelete), arg0, arg1)
+}
+
+// UpgradeWebhookGet mocks base method
+func (m *MockClient) UpgradeWebhookGet(arg0 context.Context, arg1 string) (*v1.UpgradeWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeWebhookGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.UpgradeWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeWebhookGet indicates an expected call of UpgradeWebhookGet
+func (mr *MockClientMockRecorder) UpgradeWebhookGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeWebhookGet", reflect.TypeOf((*MockClient)(nil).UpgradeWebhookGet), arg0, arg1)
+}
+
+// UpgradeWebhookList mocks base method
+func (m *MockClient) UpgradeWebhookList(arg0 context.Context) ([]v1.UpgradeWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeWebhookList", arg0)
+	ret0, _ := ret[0].([]v1.UpgradeWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeWebhookList indicates an expected call of UpgradeWebhookList
+func (mr *MockClientMockRecorder) UpgradeWebhookList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeWebhookList", reflect.TypeOf((*MockClient)(nil).UpgradeWebhookList), arg0)
+}
+
 // VolumeClassGet mocks base method
 func (m *MockClient) VolumeClassGet(arg0 context.Context, arg1 string) (*v1.VolumeClass, error) {
 	m.ctrl.T.Helper()
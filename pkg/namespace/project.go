@@ -0,0 +1,73 @@
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/baaah/pkg/router"
+	corev1 "k8s.io/api/core/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ProjectSpec returns the spec of the project that is stored in the annotations of its namespace
+func ProjectSpec(ns *corev1.Namespace) (apiv1.ProjectSpec, error) {
+	var spec apiv1.ProjectSpec
+	data, ok := ns.Annotations[labels.AcornProjectSpec]
+	if !ok || data == "" {
+		return spec, nil
+	}
+	if err := json.Unmarshal([]byte(data), &spec); err != nil {
+		return spec, fmt.Errorf("invalid %s annotation on namespace %s: %w", labels.AcornProjectSpec, ns.Name, err)
+	}
+	return spec, nil
+}
+
+// SetProjectSpec stores the spec of the project in the annotations of its namespace
+func SetProjectSpec(ns *corev1.Namespace, spec apiv1.ProjectSpec) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	if string(data) == "{}" {
+		delete(ns.Annotations, labels.AcornProjectSpec)
+		return nil
+	}
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Annotations[labels.AcornProjectSpec] = string(data)
+	return nil
+}
+
+// GetProjectSpec returns the spec of the project with the given name
+func GetProjectSpec(ctx context.Context, c client.Reader, name string) (apiv1.ProjectSpec, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, router.Key("", name), ns); err != nil {
+		return apiv1.ProjectSpec{}, err
+	}
+	return ProjectSpec(ns)
+}
+
+// Project returns the namespace of the project that the namespace belongs to. The namespaces of apps are followed up
+// to the project of the top level app. Nil is returned if the namespace does not belong to a project.
+func Project(ctx context.Context, c client.Reader, name string) (*corev1.Namespace, error) {
+	seen := map[string]bool{}
+	for name != "" && !seen[name] {
+		seen[name] = true
+		ns := &corev1.Namespace{}
+		if err := c.Get(ctx, router.Key("", name), ns); apierror.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if ns.Labels[labels.AcornProject] == "true" {
+			return ns, nil
+		}
+		name = ns.Labels[labels.AcornAppNamespace]
+	}
+	return nil, nil
+}
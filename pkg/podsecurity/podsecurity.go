@@ -0,0 +1,188 @@
+package podsecurity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The Pod Security Standards profiles, from the least to the most restrictive
+const (
+	Privileged = "privileged"
+	Baseline   = "baseline"
+	Restricted = "restricted"
+)
+
+const (
+	seccompRuntimeDefault = "RuntimeDefault"
+	seccompUnconfined     = "Unconfined"
+	seccompLocalhost      = "localhost/"
+)
+
+var (
+	profiles = []string{Privileged, Baseline, Restricted}
+
+	// baselineCapabilities are the capabilities that the baseline profile allows to be added
+	baselineCapabilities = []string{
+		"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD", "NET_BIND_SERVICE", "SETFCAP",
+		"SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+	}
+	// restrictedCapabilities are the capabilities that the restricted profile allows to be added
+	restrictedCapabilities = []string{"NET_BIND_SERVICE"}
+)
+
+// Profiles returns the names of the valid profiles
+func Profiles() []string {
+	return profiles
+}
+
+// IsValid returns true if the profile is one of the Pod Security Standards profiles
+func IsValid(profile string) bool {
+	return slices.Contains(profiles, profile)
+}
+
+// IsStricter returns true if profile a is more restrictive than profile b
+func IsStricter(a, b string) bool {
+	return slices.Index(profiles, a) > slices.Index(profiles, b)
+}
+
+// Profile returns the profile that the config enforces on app namespaces, which is privileged if none is enforced
+func Profile(cfg *apiv1.Config) string {
+	if cfg.SetPodSecurityEnforceProfile == nil || !*cfg.SetPodSecurityEnforceProfile || !IsValid(cfg.PodSecurityEnforceProfile) {
+		return Privileged
+	}
+	return cfg.PodSecurityEnforceProfile
+}
+
+// ToSecurityContext returns the security context of a container. When the restricted profile is enforced the settings
+// that the profile requires default to compliant values.
+func ToSecurityContext(profile string, sc *v1.SecurityContext) *corev1.SecurityContext {
+	if sc == nil && profile != Restricted {
+		return nil
+	}
+	if sc == nil {
+		sc = &v1.SecurityContext{}
+	}
+
+	result := &corev1.SecurityContext{
+		RunAsUser:              sc.RunAsUser,
+		RunAsGroup:             sc.RunAsGroup,
+		RunAsNonRoot:           sc.RunAsNonRoot,
+		ReadOnlyRootFilesystem: sc.ReadOnlyRootFilesystem,
+		SeccompProfile:         toSeccompProfile(sc.SeccompProfile),
+	}
+
+	if sc.Capabilities != nil {
+		result.Capabilities = &corev1.Capabilities{}
+		for _, c := range sc.Capabilities.Add {
+			result.Capabilities.Add = append(result.Capabilities.Add, corev1.Capability(normalizeCapability(c)))
+		}
+		for _, c := range sc.Capabilities.Drop {
+			result.Capabilities.Drop = append(result.Capabilities.Drop, corev1.Capability(normalizeCapability(c)))
+		}
+	}
+
+	if profile == Restricted {
+		result.AllowPrivilegeEscalation = new(bool)
+		if result.RunAsNonRoot == nil {
+			result.RunAsNonRoot = &[]bool{true}[0]
+		}
+		if result.SeccompProfile == nil {
+			result.SeccompProfile = toSeccompProfile(seccompRuntimeDefault)
+		}
+		if result.Capabilities == nil {
+			result.Capabilities = &corev1.Capabilities{}
+		}
+		if !slices.Contains(result.Capabilities.Drop, "ALL") {
+			result.Capabilities.Drop = append(result.Capabilities.Drop, "ALL")
+		}
+	}
+
+	return result
+}
+
+func toSeccompProfile(profile string) *corev1.SeccompProfile {
+	switch {
+	case profile == "":
+		return nil
+	case strings.EqualFold(profile, seccompUnconfined):
+		return &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}
+	case strings.HasPrefix(strings.ToLower(profile), seccompLocalhost):
+		return &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: &[]string{profile[len(seccompLocalhost):]}[0],
+		}
+	default:
+		return &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+}
+
+func normalizeCapability(c string) string {
+	return strings.TrimPrefix(strings.ToUpper(c), "CAP_")
+}
+
+// AddedCapabilities returns the normalized capabilities that the security context adds
+func AddedCapabilities(sc *v1.SecurityContext) (result []string) {
+	if sc == nil || sc.Capabilities == nil {
+		return nil
+	}
+	for _, c := range sc.Capabilities.Add {
+		result = append(result, normalizeCapability(c))
+	}
+	return result
+}
+
+// IsSeccompUnconfined returns true if the security context disables seccomp
+func IsSeccompUnconfined(sc *v1.SecurityContext) bool {
+	return sc != nil && strings.EqualFold(sc.SeccompProfile, seccompUnconfined)
+}
+
+// Check returns an error if the security context is invalid or is not allowed by the profile
+func Check(profile string, sc *v1.SecurityContext) error {
+	if sc == nil {
+		return nil
+	}
+
+	var errs []string
+	if sc.SeccompProfile != "" && !strings.EqualFold(sc.SeccompProfile, seccompRuntimeDefault) &&
+		!strings.EqualFold(sc.SeccompProfile, seccompUnconfined) {
+		if !strings.HasPrefix(strings.ToLower(sc.SeccompProfile), seccompLocalhost) || len(sc.SeccompProfile) == len(seccompLocalhost) {
+			errs = append(errs, fmt.Sprintf("invalid seccomp profile %q: must be %s, %s or %s<path>",
+				sc.SeccompProfile, seccompRuntimeDefault, seccompUnconfined, seccompLocalhost))
+		}
+	}
+
+	if profile == Baseline || profile == Restricted {
+		allowed := baselineCapabilities
+		if profile == Restricted {
+			allowed = restrictedCapabilities
+		}
+		for _, c := range AddedCapabilities(sc) {
+			if !slices.Contains(allowed, c) {
+				errs = append(errs, fmt.Sprintf("capability %s is not allowed by the %s profile", c, profile))
+			}
+		}
+		if IsSeccompUnconfined(sc) {
+			errs = append(errs, fmt.Sprintf("seccomp profile %s is not allowed by the %s profile", seccompUnconfined, profile))
+		}
+	}
+
+	if profile == Restricted {
+		if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+			errs = append(errs, fmt.Sprintf("runAsNonRoot must not be false with the %s profile", profile))
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			errs = append(errs, fmt.Sprintf("runAsUser must not be 0 with the %s profile", profile))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package podsecurity
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestToSecurityContext(t *testing.T) {
+	assert.Nil(t, ToSecurityContext(Baseline, nil))
+
+	sc := &v1.SecurityContext{
+		RunAsUser:      &[]int64{1000}[0],
+		SeccompProfile: "localhost/profiles/app.json",
+		Capabilities: &v1.Capabilities{
+			Add:  []string{"cap_net_bind_service"},
+			Drop: []string{"ALL"},
+		},
+	}
+	assert.Equal(t, &corev1.SecurityContext{
+		RunAsUser: &[]int64{1000}[0],
+		SeccompProfile: &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: &[]string{"profiles/app.json"}[0],
+		},
+		Capabilities: &corev1.Capabilities{
+			Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}, ToSecurityContext(Baseline, sc))
+
+	assert.Equal(t, &corev1.SecurityContext{
+		RunAsNonRoot:             &[]bool{true}[0],
+		AllowPrivilegeEscalation: new(bool),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}, ToSecurityContext(Restricted, nil))
+}
+
+func TestCheck(t *testing.T) {
+	privileged := &v1.SecurityContext{
+		RunAsUser:      new(int64),
+		SeccompProfile: "Unconfined",
+		Capabilities: &v1.Capabilities{
+			Add: []string{"NET_ADMIN"},
+		},
+	}
+	assert.NoError(t, Check(Privileged, privileged))
+	assert.EqualError(t, Check(Baseline, privileged),
+		"capability NET_ADMIN is not allowed by the baseline profile, seccomp profile Unconfined is not allowed by the baseline profile")
+	assert.EqualError(t, Check(Restricted, &v1.SecurityContext{RunAsNonRoot: new(bool), Capabilities: &v1.Capabilities{Add: []string{"CHOWN"}}}),
+		"capability CHOWN is not allowed by the restricted profile, runAsNonRoot must not be false with the restricted profile")
+	assert.NoError(t, Check(Restricted, &v1.SecurityContext{Capabilities: &v1.Capabilities{Add: []string{"NET_BIND_SERVICE"}}}))
+	assert.Error(t, Check(Privileged, &v1.SecurityContext{SeccompProfile: "localhost/"}))
+	assert.Error(t, Check(Privileged, &v1.SecurityContext{SeccompProfile: "other"}))
+}
+
+func TestIsStricter(t *testing.T) {
+	assert.True(t, IsStricter(Restricted, Baseline))
+	assert.True(t, IsStricter(Baseline, Privileged))
+	assert.False(t, IsStricter(Baseline, Baseline))
+	assert.False(t, IsStricter(Privileged, Restricted))
+}
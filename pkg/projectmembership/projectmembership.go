@@ -0,0 +1,18 @@
+package projectmembership
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rancher/wrangler/pkg/name"
+)
+
+var invalidNameChars = regexp.MustCompile("[^a-z0-9-]+")
+
+// Name returns the name of the membership of a user or group in a project. Each member of a project has a single
+// membership, so the name is derived from the kind and name of the member. The hash of the name of the member is
+// included because the name can contain characters that are not valid in the name of a resource.
+func Name(kind, member string) string {
+	readable := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(member), "-"), "-")
+	return name.SafeConcatName(strings.ToLower(kind), readable, name.Hex(member, 8))
+}
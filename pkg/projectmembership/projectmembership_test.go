@@ -0,0 +1,20 @@
+package projectmembership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestName(t *testing.T) {
+	for _, member := range []string{"alice", "alice@example.com", "system:serviceaccounts:acorn", "Alice", "-", ""} {
+		n := Name("User", member)
+		assert.Emptyf(t, validation.IsDNS1123Subdomain(n), "name %s of member %q is not valid", n, member)
+	}
+
+	assert.Regexp(t, "^user-alice-example-com-[0-9a-f]{8}$", Name("User", "alice@example.com"))
+	assert.NotEqual(t, Name("User", "alice"), Name("Group", "alice"))
+	// Members whose names only differ in characters that are not valid in a name get different memberships
+	assert.NotEqual(t, Name("User", "Alice"), Name("User", "alice"))
+}
@@ -19,6 +19,14 @@ func Bool(msg string, def bool) (result bool, _ error) {
 	return result, err
 }
 
+func Password(msg string) (result []byte, _ error) {
+	var pass string
+	err := survey.AskOne(&survey.Password{
+		Message: msg,
+	}, &pass)
+	return []byte(pass), err
+}
+
 func Remove(obj string) error {
 	if NoPromptRemove {
 		return nil
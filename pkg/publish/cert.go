@@ -5,7 +5,9 @@ import (
 	"encoding/pem"
 	"fmt"
 	"sort"
+	"strings"
 
+	"github.com/acorn-io/acorn/pkg/labels"
 	"github.com/acorn-io/acorn/pkg/system"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -67,12 +69,17 @@ func getCerts(req router.Request, namespace string) ([]*TLSCert, error) {
 		return result, err
 	}
 
-	wildcardCertSecret := &corev1.Secret{}
-	if err := req.Get(wildcardCertSecret, system.Namespace, system.TLSSecretName); err != nil && !apierrors.IsNotFound(err) {
+	// Wildcard certificates of the cluster domains are stored in the system namespace
+	var systemSecrets corev1.SecretList
+	if err := req.List(&systemSecrets, &client.ListOptions{
+		Namespace: system.Namespace,
+	}); err != nil && !apierrors.IsNotFound(err) {
 		return nil, err
 	}
-	if !apierrors.IsNotFound(err) {
-		secrets.Items = append(secrets.Items, *wildcardCertSecret)
+	for _, secret := range systemSecrets.Items {
+		if secret.Name == system.TLSSecretName || strings.HasPrefix(secret.Annotations[labels.AcornDomain], "*.") {
+			secrets.Items = append(secrets.Items, secret)
+		}
 	}
 
 	for _, secret := range secrets.Items {
@@ -136,7 +136,7 @@ func Ingress(req router.Request, app *v1.AppInstance) (result []kclient.Object,
 		return nil, nil
 	}
 
-	cfg, err := config.Get(req.Ctx, req.Client)
+	cfg, err := config.GetForProject(req.Ctx, req.Client, app.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +165,8 @@ func Ingress(req router.Request, app *v1.AppInstance) (result []kclient.Object,
 		return nil, err
 	}
 
+	customDomains := verifiedDomains(app)
+
 	for _, serviceName := range ps.ServiceNames() {
 		var (
 			rules   []networkingv1.IngressRule
@@ -179,6 +181,12 @@ func Ingress(req router.Request, app *v1.AppInstance) (result []kclient.Object,
 					rules = append(rules, rule(hostname, serviceName, port.Port))
 				}
 			}
+			if i == 0 {
+				for _, customDomain := range customDomains[serviceName] {
+					targets[customDomain] = Target{Port: port.TargetPort, Service: serviceName}
+					rules = append(rules, rule(customDomain, serviceName, port.Port))
+				}
+			}
 			svcName := serviceName
 			if i > 0 {
 				svcName = name.SafeConcatName(serviceName, fmt.Sprint(port.Port))
@@ -247,6 +255,25 @@ func Ingress(req router.Request, app *v1.AppInstance) (result []kclient.Object,
 	return result, nil
 }
 
+// verifiedDomains returns the custom domains of the app that have been verified, by the container or router they are
+// bound to. Custom domains are served on the first published port of the container or router.
+func verifiedDomains(app *v1.AppInstance) map[string][]string {
+	verified := map[string]bool{}
+	for _, status := range app.Status.Domains {
+		if status.Verified {
+			verified[status.Domain] = true
+		}
+	}
+
+	result := map[string][]string{}
+	for _, binding := range app.Spec.Domains {
+		if verified[binding.Domain] {
+			result[binding.Target] = append(result[binding.Target], binding.Domain)
+		}
+	}
+	return result
+}
+
 func setupCertManager(serviceName string, annotations map[string]string, rules []networkingv1.IngressRule, tls []networkingv1.IngressTLS) []networkingv1.IngressTLS {
 	if (annotations["cert-manager.io/cluster-issuer"] == "" && annotations["cert-manager.io/issuer"] == "") ||
 		len(tls) != 0 {
@@ -23,7 +23,7 @@ func Router(req router.Request, app *v1.AppInstance) (result []kclient.Object, _
 		return nil, nil
 	}
 
-	cfg, err := config.Get(req.Ctx, req.Client)
+	cfg, err := config.GetForProject(req.Ctx, req.Client, app.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +46,8 @@ func Router(req router.Request, app *v1.AppInstance) (result []kclient.Object, _
 		return nil, err
 	}
 
+	customDomains := verifiedDomains(app)
+
 	for _, serviceName := range ps.ServiceNames() {
 		var (
 			rules   []networkingv1.IngressRule
@@ -61,6 +63,12 @@ func Router(req router.Request, app *v1.AppInstance) (result []kclient.Object, _
 					rules = append(rules, routerRule(hostname, router))
 				}
 			}
+			if i == 0 {
+				for _, customDomain := range customDomains[serviceName] {
+					targets[customDomain] = Target{Port: port.TargetPort, Service: serviceName}
+					rules = append(rules, routerRule(customDomain, router))
+				}
+			}
 			svcName := serviceName
 			if i > 0 {
 				svcName = name.SafeConcatName(serviceName, fmt.Sprint(port.Port))
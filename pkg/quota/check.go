@@ -0,0 +1,95 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/namespace"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ForProject returns the quota of the project, nil is returned if the namespace is not a project or has no quota
+func ForProject(ctx context.Context, c kclient.Reader, namespaceName string) (*apiv1.ProjectQuota, error) {
+	spec, err := namespace.GetProjectSpec(ctx, c, namespaceName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return spec.Quota, err
+}
+
+// Check returns a message for each limit of the quota that the app takes its project over. The usage of the app is
+// compared to the usage of the app that is stored in the cluster, if any, so the app must have its app spec, scheduling
+// and volume defaults set.
+//
+// The nodes of the cluster are listed from nodes, which should be backed by a cache, and only if the app has per node
+// containers. The per node containers of the other apps of the project are counted from their status.
+func Check(ctx context.Context, c, nodes kclient.Reader, quota *apiv1.ProjectQuota, app *v1.AppInstance) ([]string, error) {
+	if quota == nil {
+		return nil, nil
+	}
+
+	apps := &v1.AppInstanceList{}
+	if err := c.List(ctx, apps, kclient.InNamespace(app.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var (
+		nodeList  []corev1.Node
+		otherApps []v1.AppInstance
+		old       Usage
+		err       error
+	)
+	if hasPerNodeContainers(app) {
+		// Per node containers have a replica on each node they can run on
+		list := &corev1.NodeList{}
+		if err := nodes.List(ctx, list); err != nil {
+			return nil, err
+		}
+		// Not nil, a nil list means the nodes are not known
+		nodeList = append([]corev1.Node{}, list.Items...)
+	}
+
+	for _, other := range apps.Items {
+		if other.Name != app.Name {
+			otherApps = append(otherApps, other)
+			continue
+		}
+		if old, err = ForApp(&other, nodeList); err != nil {
+			return nil, fmt.Errorf("calculating usage of app %s: %w", other.Name, err)
+		}
+	}
+
+	others, err := ForApps(otherApps, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := ForApp(app, nodeList)
+	if err != nil {
+		return nil, err
+	}
+
+	var before, after Usage
+	before.Add(others)
+	before.Add(old)
+	after.Add(others)
+	after.Add(usage)
+
+	// The replicas limit applies to each container, so only the replicas of this app are checked
+	before.ContainerReplicas, after.ContainerReplicas = old.ContainerReplicas, usage.ContainerReplicas
+
+	return Exceeded(quota, before, after), nil
+}
+
+func hasPerNodeContainers(app *v1.AppInstance) bool {
+	for _, container := range app.Status.AppSpec.Containers {
+		if container.PerNode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// noNodes fails to list, nodes must only be listed for apps with per node containers
+type noNodes struct {
+	kclient.Reader
+}
+
+func (noNodes) List(context.Context, kclient.ObjectList, ...kclient.ListOption) error {
+	return errors.New("nodes must not be listed")
+}
+
+func TestCheck(t *testing.T) {
+	// The app is already running with a single small container
+	existing := testApp()
+	existing.Status.AppSpec = v1.AppSpec{Containers: map[string]v1.Container{"web": {}}}
+	existing.Status.Scheduling = map[string]v1.Scheduling{"web": requests("256Mi", "250m")}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+	cpu := resource.MustParse("1")
+	apps := int32(1)
+	quota := &apiv1.ProjectQuota{CPU: &cpu, Apps: &apps}
+
+	// Upgrading the app to an image that uses more CPU than the quota is rejected
+	exceeded, err := Check(context.Background(), c, noNodes{}, quota, testApp())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CPU of 1750m exceeds the project quota of 1"}, exceeded)
+
+	// The image of a new app is not known yet, but it still counts toward the apps of the project
+	exceeded, err = Check(context.Background(), c, noNodes{}, quota, &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "acorn"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2 apps exceed the project quota of 1 apps"}, exceeded)
+
+	exceeded, err = Check(context.Background(), c, noNodes{}, nil, testApp())
+	require.NoError(t, err)
+	assert.Empty(t, exceeded)
+}
+
+func TestCheckPerNode(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+	).Build()
+	cpu := resource.MustParse("1")
+	quota := &apiv1.ProjectQuota{CPU: &cpu}
+
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "acorn"},
+		Status: v1.AppInstanceStatus{
+			AppSpec:    v1.AppSpec{Containers: map[string]v1.Container{"agent": {PerNode: true}}},
+			Scheduling: map[string]v1.Scheduling{"agent": requests("64Mi", "600m")},
+		},
+	}
+
+	// The agent has a replica on each node
+	exceeded, err := Check(context.Background(), c, c, quota, app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CPU of 1200m exceeds the project quota of 1"}, exceeded)
+
+	_, err = Check(context.Background(), c, noNodes{}, quota, app)
+	assert.EqualError(t, err, "nodes must not be listed")
+}
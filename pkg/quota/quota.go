@@ -0,0 +1,278 @@
+package quota
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/ports"
+	"github.com/acorn-io/acorn/pkg/volume"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Usage is the amount of the resources limited by a project quota that apps use
+type Usage struct {
+	Apps               int32             `json:"apps"`
+	Memory             resource.Quantity `json:"memory"`
+	CPU                resource.Quantity `json:"cpu"`
+	VolumeStorage      resource.Quantity `json:"volumeStorage"`
+	PublishedEndpoints int32             `json:"publishedEndpoints"`
+	// ContainerReplicas is the highest number of replicas of a single container
+	ContainerReplicas int32 `json:"containerReplicas"`
+}
+
+// Add adds the usage of other to u
+func (u *Usage) Add(other Usage) {
+	u.Apps += other.Apps
+	u.Memory.Add(other.Memory)
+	u.CPU.Add(other.CPU)
+	u.VolumeStorage.Add(other.VolumeStorage)
+	u.PublishedEndpoints += other.PublishedEndpoints
+	if other.ContainerReplicas > u.ContainerReplicas {
+		u.ContainerReplicas = other.ContainerReplicas
+	}
+}
+
+// ForApp returns the usage of the app. Memory and CPU are the requests of the scheduling requirements of the workloads
+// times their replicas, volume storage is the size of the volumes created by the app, and published endpoints are the
+// ports published through ingress, routers, and load balancers. Stopped apps only use volume storage.
+//
+// Per node containers have a replica on each of the nodes that they can be scheduled on. If nodes is nil, the nodes of
+// the cluster are not known and the replicas of per node containers are taken from the status of the app.
+func ForApp(app *v1.AppInstance, nodes []corev1.Node) (Usage, error) {
+	usage := Usage{
+		Apps: 1,
+	}
+
+	stopped := app.Spec.Stop != nil && *app.Spec.Stop
+	if !stopped {
+		for name, container := range app.Status.AppSpec.Containers {
+			replicas := int32(1)
+			if container.PerNode {
+				replicas = perNodeReplicas(app, name, nodes)
+			} else if container.Scale != nil {
+				replicas = *container.Scale
+			}
+			if replicas > usage.ContainerReplicas {
+				usage.ContainerReplicas = replicas
+			}
+			addRequests(&usage, app.Status.Scheduling[name], replicas)
+			for sidecarName := range container.Sidecars {
+				addRequests(&usage, app.Status.Scheduling[sidecarName], replicas)
+			}
+		}
+		for name := range app.Status.AppSpec.Jobs {
+			addRequests(&usage, app.Status.Scheduling[name], 1)
+		}
+	}
+
+	bindings := volume.SliceToMap(app.Spec.Volumes, func(vb v1.VolumeBinding) string {
+		return vb.Target
+	})
+	copies := volumeCopies(app)
+	for name, vol := range app.Status.AppSpec.Volumes {
+		binding, bind := bindings[name]
+		if bind && binding.Volume != "" {
+			// Existing volumes were counted by the app that created them
+			continue
+		}
+		vol = volume.CopyVolumeDefaults(vol, binding, app.Status.Defaults.Volumes[name])
+		if strings.EqualFold(vol.Class, v1.VolumeRequestTypeEphemeral) {
+			continue
+		}
+		size := *v1.DefaultSize
+		if vol.Size != "" {
+			var err error
+			size, err = resource.ParseQuantity(string(vol.Size))
+			if err != nil {
+				return usage, fmt.Errorf("invalid size of volume %s: %w", name, err)
+			}
+		}
+		if n, ok := copies[name]; ok {
+			size = *resource.NewQuantity(size.Value()*int64(n), size.Format)
+		}
+		usage.VolumeStorage.Add(size)
+	}
+
+	for _, publish := range []func(*v1.AppInstance) (*ports.Set, error){
+		ports.NewForIngressPublish,
+		ports.NewForRouterPublish,
+		ports.NewForServiceLBPublish,
+	} {
+		set, err := publish(app)
+		if err != nil {
+			return usage, err
+		}
+		usage.PublishedEndpoints += int32(len(set.Ports))
+	}
+
+	return usage, nil
+}
+
+// perNodeReplicas returns the number of nodes that the per node container can be scheduled on, or the desired replicas
+// in the status of the app if nodes is nil. At least one replica is counted.
+func perNodeReplicas(app *v1.AppInstance, name string, nodes []corev1.Node) int32 {
+	var replicas int32
+	if nodes == nil {
+		replicas = app.Status.ContainerStatus[name].ReadyDesired
+	} else {
+		scheduling := app.Status.Scheduling[name]
+		for _, node := range nodes {
+			if nodeMatches(node, scheduling) {
+				replicas++
+			}
+		}
+	}
+	if replicas < 1 {
+		return 1
+	}
+	return replicas
+}
+
+// nodeMatches returns true if the node matches the required node affinity of the scheduling and all of its NoSchedule
+// and NoExecute taints are tolerated
+func nodeMatches(node corev1.Node, scheduling v1.Scheduling) bool {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if slices.IndexFunc(scheduling.Tolerations, func(toleration corev1.Toleration) bool {
+			return toleration.ToleratesTaint(taint)
+		}) < 0 {
+			return false
+		}
+	}
+
+	if scheduling.Affinity == nil || scheduling.Affinity.NodeAffinity == nil ||
+		scheduling.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	for _, term := range scheduling.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if termMatches(node, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// termMatches returns true if the node matches all the requirements of the term, an empty term matches no nodes
+func termMatches(node corev1.Node, term corev1.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, expr := range term.MatchExpressions {
+		if !requirementMatches(node.Labels, expr) {
+			return false
+		}
+	}
+	for _, expr := range term.MatchFields {
+		// metadata.name is the only field supported by node selectors
+		if !requirementMatches(map[string]string{"metadata.name": node.Name}, expr) {
+			return false
+		}
+	}
+	return true
+}
+
+func requirementMatches(values map[string]string, expr corev1.NodeSelectorRequirement) bool {
+	var op selection.Operator
+	switch expr.Operator {
+	case corev1.NodeSelectorOpIn:
+		op = selection.In
+	case corev1.NodeSelectorOpNotIn:
+		op = selection.NotIn
+	case corev1.NodeSelectorOpExists:
+		op = selection.Exists
+	case corev1.NodeSelectorOpDoesNotExist:
+		op = selection.DoesNotExist
+	case corev1.NodeSelectorOpGt:
+		op = selection.GreaterThan
+	case corev1.NodeSelectorOpLt:
+		op = selection.LessThan
+	default:
+		return false
+	}
+	req, err := labels.NewRequirement(expr.Key, op, expr.Values)
+	if err != nil {
+		return false
+	}
+	return req.Matches(labels.Set(values))
+}
+
+// volumeCopies returns the number of copies of the volumes that stateful containers use, which is one for each replica
+func volumeCopies(app *v1.AppInstance) map[string]int32 {
+	result := map[string]int32{}
+	for _, container := range app.Status.AppSpec.Containers {
+		if !container.Stateful {
+			continue
+		}
+		replicas := int32(1)
+		if container.Scale != nil {
+			replicas = *container.Scale
+		}
+		for _, c := range append([]v1.Container{container}, maps.Values(container.Sidecars)...) {
+			for _, dir := range c.Dirs {
+				if dir.ContextDir == "" && dir.Secret.Name == "" && dir.Volume != "" {
+					result[dir.Volume] = replicas
+				}
+			}
+		}
+	}
+	return result
+}
+
+func addRequests(usage *Usage, scheduling v1.Scheduling, replicas int32) {
+	if memory, ok := scheduling.Requirements.Requests[corev1.ResourceMemory]; ok {
+		usage.Memory.Add(*resource.NewQuantity(memory.Value()*int64(replicas), memory.Format))
+	}
+	if cpu, ok := scheduling.Requirements.Requests[corev1.ResourceCPU]; ok {
+		usage.CPU.Add(*resource.NewMilliQuantity(cpu.MilliValue()*int64(replicas), cpu.Format))
+	}
+}
+
+// ForApps returns the total usage of the apps
+func ForApps(apps []v1.AppInstance, nodes []corev1.Node) (Usage, error) {
+	var total Usage
+	for i := range apps {
+		usage, err := ForApp(&apps[i], nodes)
+		if err != nil {
+			return total, fmt.Errorf("calculating usage of app %s: %w", apps[i].Name, err)
+		}
+		total.Add(usage)
+	}
+	return total, nil
+}
+
+// Exceeded returns a message for each limit of the quota that the usage after a change is over, limits that the usage
+// was already over before the change are only reported if the change increases the usage.
+func Exceeded(quota *apiv1.ProjectQuota, before, after Usage) (result []string) {
+	if quota == nil {
+		return nil
+	}
+	if quota.Apps != nil && after.Apps > *quota.Apps && after.Apps > before.Apps {
+		result = append(result, fmt.Sprintf("%d apps exceed the project quota of %d apps", after.Apps, *quota.Apps))
+	}
+	if quota.Memory != nil && after.Memory.Cmp(*quota.Memory) > 0 && after.Memory.Cmp(before.Memory) > 0 {
+		result = append(result, fmt.Sprintf("memory of %s exceeds the project quota of %s", after.Memory.String(), quota.Memory.String()))
+	}
+	if quota.CPU != nil && after.CPU.Cmp(*quota.CPU) > 0 && after.CPU.Cmp(before.CPU) > 0 {
+		result = append(result, fmt.Sprintf("CPU of %s exceeds the project quota of %s", after.CPU.String(), quota.CPU.String()))
+	}
+	if quota.VolumeStorage != nil && after.VolumeStorage.Cmp(*quota.VolumeStorage) > 0 && after.VolumeStorage.Cmp(before.VolumeStorage) > 0 {
+		result = append(result, fmt.Sprintf("volume storage of %s exceeds the project quota of %s", after.VolumeStorage.String(), quota.VolumeStorage.String()))
+	}
+	if quota.PublishedEndpoints != nil && after.PublishedEndpoints > *quota.PublishedEndpoints && after.PublishedEndpoints > before.PublishedEndpoints {
+		result = append(result, fmt.Sprintf("%d published endpoints exceed the project quota of %d", after.PublishedEndpoints, *quota.PublishedEndpoints))
+	}
+	if quota.ContainerReplicas != nil && after.ContainerReplicas > *quota.ContainerReplicas && after.ContainerReplicas > before.ContainerReplicas {
+		result = append(result, fmt.Sprintf("%d replicas of a container exceed the project quota of %d", after.ContainerReplicas, *quota.ContainerReplicas))
+	}
+	return result
+}
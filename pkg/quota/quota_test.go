@@ -0,0 +1,188 @@
+package quota
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func requests(memory, cpu string) v1.Scheduling {
+	list := corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)}
+	if cpu != "" {
+		list[corev1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	return v1.Scheduling{Requirements: corev1.ResourceRequirements{Requests: list}}
+}
+
+func testApp() *v1.AppInstance {
+	scale := int32(3)
+	return &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"},
+		Spec: v1.AppInstanceSpec{
+			Volumes: []v1.VolumeBinding{
+				{Target: "bound", Volume: "existing"},
+				{Target: "resized", Size: "20G"},
+			},
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Scale: &scale,
+						Ports: []v1.PortDef{{Port: 80, Protocol: v1.ProtocolHTTP, Publish: true}},
+						Sidecars: map[string]v1.Container{
+							"proxy": {},
+						},
+					},
+					"db": {
+						Ports: []v1.PortDef{{Port: 5432, Protocol: v1.ProtocolTCP}},
+					},
+				},
+				Jobs: map[string]v1.Container{
+					"migrate": {},
+				},
+				Volumes: map[string]v1.VolumeRequest{
+					"data":      {},
+					"bound":     {},
+					"resized":   {},
+					"scratch":   {Class: v1.VolumeRequestTypeEphemeral},
+					"defaulted": {},
+				},
+			},
+			Scheduling: map[string]v1.Scheduling{
+				"web":     requests("256Mi", "250m"),
+				"proxy":   requests("64Mi", ""),
+				"db":      requests("1Gi", "1"),
+				"migrate": requests("128Mi", ""),
+			},
+			Defaults: v1.Defaults{
+				Volumes: map[string]v1.VolumeDefault{
+					"defaulted": {Size: "5G"},
+				},
+			},
+		},
+	}
+}
+
+func TestForApp(t *testing.T) {
+	usage, err := ForApp(testApp(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), usage.Apps)
+	// 3 * (256Mi + 64Mi) + 1Gi + 128Mi
+	assert.Equal(t, "2112Mi", usage.Memory.String())
+	// 3 * 250m + 1
+	assert.Equal(t, "1750m", usage.CPU.String())
+	// data has the default size, bound is an existing volume and scratch is ephemeral
+	assert.Equal(t, "35G", usage.VolumeStorage.String())
+	assert.Equal(t, int32(1), usage.PublishedEndpoints)
+	assert.Equal(t, int32(3), usage.ContainerReplicas)
+}
+
+func TestForAppStopped(t *testing.T) {
+	app := testApp()
+	stop := true
+	app.Spec.Stop = &stop
+
+	usage, err := ForApp(app, nil)
+	require.NoError(t, err)
+	assert.True(t, usage.Memory.IsZero())
+	assert.True(t, usage.CPU.IsZero())
+	assert.Zero(t, usage.ContainerReplicas)
+	assert.Equal(t, "35G", usage.VolumeStorage.String())
+}
+
+func TestForAppStatefulVolumes(t *testing.T) {
+	app := testApp()
+	scale := int32(2)
+	db := app.Status.AppSpec.Containers["db"]
+	db.Stateful = true
+	db.Scale = &scale
+	db.Dirs = map[string]v1.VolumeMount{"/var/lib/data": {Volume: "data"}}
+	app.Status.AppSpec.Containers["db"] = db
+
+	usage, err := ForApp(app, nil)
+	require.NoError(t, err)
+	// each replica of db has its own copy of data
+	assert.Equal(t, "45G", usage.VolumeStorage.String())
+}
+
+func TestForAppPerNode(t *testing.T) {
+	app := testApp()
+	db := app.Status.AppSpec.Containers["db"]
+	db.PerNode = true
+	app.Status.AppSpec.Containers["db"] = db
+	app.Status.Scheduling["db"] = v1.Scheduling{
+		Requirements: requests("1Gi", "1").Requirements,
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      "pool",
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"db"},
+						}},
+					}},
+				},
+			},
+		},
+		Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+	}
+
+	node := func(name, pool string, taints ...corev1.Taint) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}},
+			Spec:       corev1.NodeSpec{Taints: taints},
+		}
+	}
+	nodes := []corev1.Node{
+		node("db-1", "db"),
+		node("db-2", "db", corev1.Taint{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule}),
+		node("db-3", "db", corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+		node("web-1", "web"),
+	}
+
+	usage, err := ForApp(app, nodes)
+	require.NoError(t, err)
+	// db runs on db-1 and db-2, db-3 has a taint that isn't tolerated and web-1 doesn't match the affinity
+	// 3 * (256Mi + 64Mi) + 2 * 1Gi + 128Mi
+	assert.Equal(t, "3136Mi", usage.Memory.String())
+	assert.Equal(t, "2750m", usage.CPU.String())
+	assert.Equal(t, int32(3), usage.ContainerReplicas)
+
+	// Without the nodes the desired replicas of the status are used
+	app.Status.ContainerStatus = map[string]v1.ContainerStatus{"db": {ReadyDesired: 5}}
+	usage, err = ForApp(app, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), usage.ContainerReplicas)
+	assert.Equal(t, "5750m", usage.CPU.String())
+}
+
+func TestExceeded(t *testing.T) {
+	apps, memory, replicas := int32(2), resource.MustParse("1Gi"), int32(2)
+	quota := &apiv1.ProjectQuota{
+		Apps:              &apps,
+		Memory:            &memory,
+		ContainerReplicas: &replicas,
+	}
+
+	before := Usage{Apps: 2, Memory: resource.MustParse("512Mi"), ContainerReplicas: 1}
+	assert.Empty(t, Exceeded(quota, Usage{}, before))
+
+	after := Usage{Apps: 3, Memory: resource.MustParse("2Gi"), ContainerReplicas: 3}
+	assert.Equal(t, []string{
+		"3 apps exceed the project quota of 2 apps",
+		"memory of 2Gi exceeds the project quota of 1Gi",
+		"3 replicas of a container exceed the project quota of 2",
+	}, Exceeded(quota, before, after))
+
+	// Changes that do not increase the usage are allowed when the project is already over its quota
+	assert.Empty(t, Exceeded(quota, after, Usage{Apps: 3, Memory: resource.MustParse("1536Mi"), ContainerReplicas: 3}))
+}
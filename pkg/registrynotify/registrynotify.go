@@ -0,0 +1,272 @@
+package registrynotify
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/autoupgrade"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PathPrefix is the path of the endpoint, the project name follows it
+	PathPrefix = "/registry-notifications/"
+	// TokenHeader holds the token of the project. The token can also be passed as the password of HTTP basic auth.
+	TokenHeader = "X-Acorn-Registry-Token"
+	// TokenSecretName is the name of the secret in the project that holds the token
+	TokenSecretName = "registry-notification-token"
+	// TokenSecretKey is the key of the token secret that holds the token
+	TokenSecretKey = "token"
+
+	maxBodySize = 1 << 20
+)
+
+// Push is an image pushed to a registry
+type Push struct {
+	Registry   string `json:"registry,omitempty"`
+	Repository string `json:"repository"`
+	Tag        string `json:"tag,omitempty"`
+	Digest     string `json:"digest,omitempty"`
+}
+
+// envelope is the Docker Distribution notification format
+type envelope struct {
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+			Digest     string `json:"digest"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+// generic is the payload for registries that don't send Docker Distribution notifications. Either image or repository
+// must be set.
+type generic struct {
+	Image string `json:"image"`
+	Push
+}
+
+// Parse returns the pushes in the body, which is either a Docker Distribution notification envelope or a generic
+// payload like {"image": "ghcr.io/acorn-io/app:v1.0.0"}
+func Parse(body []byte) ([]Push, error) {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, err
+	}
+	if env.Events != nil {
+		var result []Push
+		for _, event := range env.Events {
+			if event.Action != "push" || event.Target.Repository == "" {
+				continue
+			}
+			result = append(result, Push{
+				Registry:   event.Request.Host,
+				Repository: event.Target.Repository,
+				Tag:        event.Target.Tag,
+				Digest:     event.Target.Digest,
+			})
+		}
+		return result, nil
+	}
+
+	var g generic
+	if err := json.Unmarshal(body, &g); err != nil {
+		return nil, err
+	}
+	if g.Image != "" {
+		ref, err := name.ParseReference(g.Image)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image %s: %w", g.Image, err)
+		}
+		g.Repository = ref.Context().Name()
+		switch r := ref.(type) {
+		case name.Tag:
+			g.Tag = r.TagStr()
+		case name.Digest:
+			g.Digest = r.DigestStr()
+		}
+	}
+	if g.Repository == "" {
+		return nil, fmt.Errorf("payload must either be a registry notification envelope or contain an image or repository")
+	}
+	return []Push{g.Push}, nil
+}
+
+// Matches returns true if the push is to the repository of the app's image. When the push doesn't include the
+// registry, only the repository path is compared.
+func Matches(app v1.AppInstance, push Push) bool {
+	if _, ok := autoupgrade.Mode(app.Spec); !ok {
+		return false
+	}
+
+	image := app.Status.AppImage.Name
+	if image == "" {
+		image = app.Spec.Image
+		if pattern, ok := autoupgrade.AutoUpgradePattern(image); ok {
+			image = strings.TrimSuffix(image, ":"+pattern)
+		}
+	}
+	appRef, err := name.ParseReference(image)
+	if err != nil {
+		return false
+	}
+
+	repo := push.Repository
+	if push.Registry != "" {
+		repo = push.Registry + "/" + repo
+	}
+	pushRepo, err := name.NewRepository(repo)
+	if err != nil {
+		return false
+	}
+
+	if push.Registry == "" && !looksLikeRegistry(push.Repository) {
+		return pushRepo.RepositoryStr() == appRef.Context().RepositoryStr()
+	}
+	return pushRepo.Name() == appRef.Context().Name()
+}
+
+// looksLikeRegistry returns true if the first component of the repository is a registry host
+func looksLikeRegistry(repository string) bool {
+	first, _, _ := strings.Cut(repository, "/")
+	return strings.ContainsAny(first, ".:") || first == "localhost"
+}
+
+// Handler serves registry push notifications. It requests an immediate auto-upgrade check of every app in the project
+// whose image is in a pushed repository. The auto-upgrade daemon keeps polling, so apps are still upgraded if a
+// notification is lost.
+type Handler struct {
+	client kclient.Client
+}
+
+func NewHandler(c kclient.Client) *Handler {
+	return &Handler{client: c}
+}
+
+type response struct {
+	Apps []string `json:"apps"`
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	project := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, PathPrefix), "/")
+	if project == "" || strings.Contains(project, "/") {
+		http.NotFound(rw, req)
+		return
+	}
+
+	if !h.authenticate(req.Context(), project, req) {
+		http.Error(rw, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	pushes, err := Parse(body)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid notification: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	apps, err := h.requestChecks(req.Context(), project, pushes, time.Now())
+	if err != nil {
+		logrus.Errorf("Failed to handle registry notification for project %s: %v", project, err)
+		http.Error(rw, "failed to handle notification", http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(response{Apps: apps})
+}
+
+// authenticate compares the token of the request to the token of the project. Requests to projects without a token
+// are rejected.
+func (h *Handler) authenticate(ctx context.Context, project string, req *http.Request) bool {
+	token := req.Header.Get(TokenHeader)
+	if token == "" {
+		// Not accepted in the query, URLs end up in the access logs of the registry, proxies and the API server
+		_, token, _ = req.BasicAuth()
+	}
+	if token == "" {
+		return false
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.client.Get(ctx, kclient.ObjectKey{Namespace: project, Name: TokenSecretName}, secret); err != nil {
+		return false
+	}
+	expected := secret.Data[TokenSecretKey]
+	return len(expected) > 0 && subtle.ConstantTimeCompare(expected, []byte(token)) == 1
+}
+
+// requestChecks annotates the apps in the project that match one of the pushes and returns their names
+func (h *Handler) requestChecks(ctx context.Context, project string, pushes []Push, now time.Time) ([]string, error) {
+	result := []string{}
+	if len(pushes) == 0 {
+		return result, nil
+	}
+
+	apps := &v1.AppInstanceList{}
+	if err := h.client.List(ctx, apps, &kclient.ListOptions{
+		Namespace: project,
+	}); err != nil {
+		return nil, err
+	}
+
+	requested := now.UTC().Format(time.RFC3339Nano)
+	for _, app := range apps.Items {
+		if !matchesAny(app, pushes) {
+			continue
+		}
+
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest := &v1.AppInstance{}
+			if err := h.client.Get(ctx, kclient.ObjectKeyFromObject(&app), latest); err != nil {
+				return err
+			}
+			latest.Annotations = labels.Merge(latest.Annotations, map[string]string{
+				labels.AcornUpgradeCheckRequested: requested,
+			})
+			return h.client.Update(ctx, latest)
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, app.Name)
+	}
+	return result, nil
+}
+
+func matchesAny(app v1.AppInstance, pushes []Push) bool {
+	for _, push := range pushes {
+		if Matches(app, push) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package registrynotify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const distributionEnvelope = `{
+  "events": [
+    {
+      "action": "pull",
+      "target": {"repository": "acorn/ignored", "tag": "latest"}
+    },
+    {
+      "action": "push",
+      "target": {"repository": "acorn/app", "tag": "v1.0.1", "digest": "sha256:abcd"},
+      "request": {"host": "registry.example.com"}
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []Push
+		wantErr bool
+	}{
+		{
+			name: "docker distribution envelope",
+			body: distributionEnvelope,
+			want: []Push{{Registry: "registry.example.com", Repository: "acorn/app", Tag: "v1.0.1", Digest: "sha256:abcd"}},
+		},
+		{
+			name: "empty envelope",
+			body: `{"events": []}`,
+		},
+		{
+			name: "generic image",
+			body: `{"image": "ghcr.io/acorn-io/app:v2"}`,
+			want: []Push{{Repository: "ghcr.io/acorn-io/app", Tag: "v2"}},
+		},
+		{
+			name: "generic repository",
+			body: `{"repository": "acorn-io/app", "digest": "sha256:abcd"}`,
+			want: []Push{{Repository: "acorn-io/app", Digest: "sha256:abcd"}},
+		},
+		{
+			name:    "generic without image",
+			body:    `{"tag": "v2"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.body))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	ptrTrue := &[]bool{true}[0]
+	app := func(image string, autoUpgrade bool) v1.AppInstance {
+		a := v1.AppInstance{Spec: v1.AppInstanceSpec{Image: image}}
+		if autoUpgrade {
+			a.Spec.AutoUpgrade = ptrTrue
+		}
+		return a
+	}
+
+	tests := []struct {
+		name string
+		app  v1.AppInstance
+		push Push
+		want bool
+	}{
+		{
+			name: "registry and repository match",
+			app:  app("registry.example.com/acorn/app:latest", true),
+			push: Push{Registry: "registry.example.com", Repository: "acorn/app"},
+			want: true,
+		},
+		{
+			name: "different registry",
+			app:  app("ghcr.io/acorn/app:latest", true),
+			push: Push{Registry: "registry.example.com", Repository: "acorn/app"},
+		},
+		{
+			name: "different repository",
+			app:  app("registry.example.com/acorn/other:latest", true),
+			push: Push{Registry: "registry.example.com", Repository: "acorn/app"},
+		},
+		{
+			name: "auto-upgrade disabled",
+			app:  app("registry.example.com/acorn/app:latest", false),
+			push: Push{Registry: "registry.example.com", Repository: "acorn/app"},
+		},
+		{
+			name: "tag pattern",
+			app:  app("registry.example.com/acorn/app:v#.#.#", false),
+			push: Push{Registry: "registry.example.com", Repository: "acorn/app", Tag: "v1.0.1"},
+			want: true,
+		},
+		{
+			name: "repository without registry only compares the path",
+			app:  app("ghcr.io/acorn/app:latest", true),
+			push: Push{Repository: "acorn/app"},
+			want: true,
+		},
+		{
+			name: "docker hub image",
+			app:  app("acorn/app:latest", true),
+			push: Push{Repository: "index.docker.io/acorn/app"},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Matches(tt.app, tt.push))
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ptrTrue := &[]bool{true}[0]
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: TokenSecretName, Namespace: "acorn"},
+			Data:       map[string][]byte{TokenSecretKey: []byte("s3cr3t")},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "matching", Namespace: "acorn"},
+			Spec:       v1.AppInstanceSpec{Image: "registry.example.com/acorn/app:latest", AutoUpgrade: ptrTrue},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "acorn"},
+			Spec:       v1.AppInstanceSpec{Image: "registry.example.com/acorn/other:latest", AutoUpgrade: ptrTrue},
+		},
+	).Build()
+	server := httptest.NewServer(NewHandler(c))
+	defer server.Close()
+
+	post := func(path, token, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set(TokenHeader, token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, post(PathPrefix+"acorn", "", distributionEnvelope).StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, post(PathPrefix+"acorn", "wrong", distributionEnvelope).StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, post(PathPrefix+"no-token", "s3cr3t", distributionEnvelope).StatusCode)
+	assert.Equal(t, http.StatusBadRequest, post(PathPrefix+"acorn", "s3cr3t", "not json").StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, post(PathPrefix+"acorn?token=s3cr3t", "", distributionEnvelope).StatusCode)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+PathPrefix+"acorn", strings.NewReader(distributionEnvelope))
+	require.NoError(t, err)
+	req.SetBasicAuth("acorn", "s3cr3t")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	app := &v1.AppInstance{}
+	require.NoError(t, c.Get(context.Background(), kclient.ObjectKey{Namespace: "acorn", Name: "matching"}, app))
+	assert.NotEmpty(t, app.Annotations[labels.AcornUpgradeCheckRequested])
+
+	require.NoError(t, c.Get(context.Background(), kclient.ObjectKey{Namespace: "acorn", Name: "other"}, app))
+	assert.Empty(t, app.Annotations[labels.AcornUpgradeCheckRequested])
+}
@@ -3,21 +3,32 @@ package roles
 import (
 	admin_acorn_io "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io"
 	api_acorn_io "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	Admin       = "acorn:project:admin"
-	View        = "acorn:project:view"
-	ViewLogs    = "acorn:project:view-logs"
-	Edit        = "acorn:project:edit"
-	Build       = "acorn:project:build"
-	ClusterView = "acorn:cluster:view"
-	ClusterEdit = "acorn:cluster:edit"
+	Admin        = "acorn:project:admin"
+	View         = "acorn:project:view"
+	ViewLogs     = "acorn:project:view-logs"
+	Edit         = "acorn:project:edit"
+	Build        = "acorn:project:build"
+	ClusterView  = "acorn:cluster:view"
+	ClusterEdit  = "acorn:cluster:edit"
+	ClusterAdmin = "acorn:cluster:admin"
 )
 
 var (
+	// ProjectRoles maps the names of the roles that can be granted to the members of a project to their cluster roles
+	ProjectRoles = map[string]string{
+		v1.ProjectRoleAdmin:    Admin,
+		v1.ProjectRoleEdit:     Edit,
+		v1.ProjectRoleView:     View,
+		v1.ProjectRoleViewLogs: ViewLogs,
+		v1.ProjectRoleBuild:    Build,
+	}
+
 	clusterRoles = map[string][]rbacv1.PolicyRule{
 		ClusterView: {
 			{
@@ -35,6 +46,22 @@ var (
 				},
 			},
 		},
+		// The spec of a project holds its quota and config overrides, so only cluster admins can update it. Listing
+		// audit entries across namespaces returns the entries of all projects.
+		ClusterAdmin: {
+			{
+				Verbs: []string{"update"},
+				Resources: []string{
+					"projects",
+				},
+			},
+			{
+				Verbs: []string{"list"},
+				Resources: []string{
+					"auditentries",
+				},
+			},
+		},
 	}
 	projectRoles = map[string][]rbacv1.PolicyRule{
 		View: {
@@ -49,6 +76,8 @@ var (
 					"containerreplicas",
 					"credentials",
 					"secrets",
+					"upgradewebhooks",
+					"projectmemberships",
 				},
 			},
 			{
@@ -56,6 +85,7 @@ var (
 				Resources: []string{
 					"volumeclasses",
 					"computeclasses",
+					"imagepolicies",
 				},
 			},
 			{
@@ -86,6 +116,7 @@ var (
 					"apps",
 					"credentials",
 					"secrets",
+					"upgradewebhooks",
 				},
 			},
 			{
@@ -98,6 +129,9 @@ var (
 				Verbs: []string{"create"},
 				Resources: []string{
 					"images/tag",
+					"images/signature",
+					"images/scan",
+					"imageprunes",
 					"apps/confirmupgrade",
 					"apps/pullimage",
 				},
@@ -115,6 +149,7 @@ var (
 					"images/push",
 					"images/pull",
 					"containerreplicas/exec",
+					"containerreplicas/portforward",
 					"secrets/reveal",
 				},
 			},
@@ -142,6 +177,8 @@ var (
 					"clustervolumeclasses",
 					"projectcomputeclasses",
 					"clustercomputeclasses",
+					"projectimagepolicies",
+					"clusterimagepolicies",
 				},
 				APIGroups: []string{admin_acorn_io.Group},
 			},
@@ -149,6 +186,23 @@ var (
 	}
 )
 
+// adminRules are granted to the admins of a project in addition to the rules of the edit and build roles. The rules
+// of projectRoles[Admin] manage the classes and image policies of the cluster and are not granted to project admins.
+var adminRules = []rbacv1.PolicyRule{
+	{
+		Verbs: []string{"create", "update", "delete", "patch"},
+		Resources: []string{
+			"projectmemberships",
+		},
+	},
+	{
+		Verbs: []string{"list"},
+		Resources: []string{
+			"auditentries",
+		},
+	},
+}
+
 func addAPIGroup(roles []rbacv1.ClusterRole) []rbacv1.ClusterRole {
 	for i := range roles {
 		for j := range roles[i].Rules {
@@ -190,11 +244,17 @@ func ClusterRoles() []rbacv1.ClusterRole {
 			},
 			Rules: clusterRolesConcat(ClusterView, ClusterEdit),
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ClusterAdmin,
+			},
+			Rules: clusterRolesConcat(ClusterView, ClusterEdit, ClusterAdmin),
+		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: Admin,
 			},
-			Rules: concat(View, ViewLogs, Edit, Build),
+			Rules: append(concat(View, ViewLogs, Edit, Build), adminRules...),
 		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
@@ -222,3 +282,13 @@ func ClusterRoles() []rbacv1.ClusterRole {
 		},
 	})
 }
+
+// Rules returns the rules of the Acorn cluster role with the name, nil is returned if there is no such role
+func Rules(name string) []rbacv1.PolicyRule {
+	for _, role := range ClusterRoles() {
+		if role.Name == name {
+			return role.Rules
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package roles
+
+import (
+	"testing"
+
+	admin_acorn_io "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestAdminRules(t *testing.T) {
+	var resources []string
+	for _, rule := range Rules(Admin) {
+		assert.NotContains(t, rule.APIGroups, admin_acorn_io.Group)
+		resources = append(resources, rule.Resources...)
+	}
+	assert.Contains(t, resources, "projectmemberships")
+	assert.Contains(t, resources, "auditentries")
+}
+
+func TestClusterRoles(t *testing.T) {
+	canUpdateProjects := func(name string) bool {
+		for _, rule := range Rules(name) {
+			for _, resource := range rule.Resources {
+				if resource == "projects" && slices.Contains(rule.Verbs, "update") {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	assert.False(t, canUpdateProjects(ClusterEdit))
+	assert.True(t, canUpdateProjects(ClusterAdmin))
+}
+
+func TestClusterAuditEntries(t *testing.T) {
+	canListAuditEntries := func(name string) bool {
+		for _, rule := range Rules(name) {
+			if slices.Contains(rule.Resources, "auditentries") && slices.Contains(rule.Verbs, "list") {
+				return true
+			}
+		}
+		return false
+	}
+	assert.False(t, canListAuditEntries(ClusterView))
+	assert.False(t, canListAuditEntries(ClusterEdit))
+	assert.True(t, canListAuditEntries(ClusterAdmin))
+}
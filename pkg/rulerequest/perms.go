@@ -1,6 +1,8 @@
 package rulerequest
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
@@ -19,6 +21,70 @@ func ToRuleRequests(perms []v1.Permissions) (result []RuleRequest) {
 	for _, perm := range perms {
 		result = append(result, clusterRulesToRequests(perm.ServiceName, perm.ClusterRules)...)
 		result = append(result, rulesToRequests(perm.ServiceName, perm.Rules)...)
+		result = append(result, egressToRequests(perm.ServiceName, perm.Egress)...)
+		result = append(result, privilegesToRequests(perm)...)
+	}
+	return
+}
+
+func privilegesToRequests(perm v1.Permissions) (result []RuleRequest) {
+	for _, capability := range perm.Capabilities {
+		result = append(result, RuleRequest{
+			Service:  perm.ServiceName,
+			Scope:    "container",
+			Verbs:    "add",
+			Resource: "capability/" + capability,
+		})
+	}
+	if perm.SeccompUnconfined {
+		result = append(result, RuleRequest{
+			Service:  perm.ServiceName,
+			Scope:    "container",
+			Verbs:    "use",
+			Resource: "seccomp/Unconfined",
+		})
+	}
+	if perm.PerNode {
+		result = append(result, RuleRequest{
+			Service:  perm.ServiceName,
+			Scope:    "node",
+			Verbs:    "run",
+			Resource: "*",
+		})
+	}
+	return
+}
+
+func egressToRequests(serviceName string, rules []v1.EgressRule) (result []RuleRequest) {
+	for _, rule := range rules {
+		destinations := append(append([]string{}, rule.Hosts...), rule.CIDRs...)
+		if len(destinations) == 0 {
+			destinations = []string{"*"}
+		}
+
+		protocol := rule.Protocol
+		if protocol == "" {
+			protocol = v1.ProtocolTCP
+		}
+
+		ports := []string{"*"}
+		if len(rule.Ports) > 0 {
+			ports = nil
+			for _, port := range rule.Ports {
+				ports = append(ports, strconv.Itoa(int(port)))
+			}
+		}
+
+		for _, destination := range destinations {
+			for _, port := range ports {
+				result = append(result, RuleRequest{
+					Service:  serviceName,
+					Scope:    "egress",
+					Verbs:    "connect",
+					Resource: fmt.Sprintf("%s:%s/%s", destination, port, protocol),
+				})
+			}
+		}
 	}
 	return
 }
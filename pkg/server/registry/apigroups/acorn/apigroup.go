@@ -3,21 +3,26 @@ package acorn
 import (
 	api "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
 	v1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/audit"
 	"github.com/acorn-io/acorn/pkg/client"
 	"github.com/acorn-io/acorn/pkg/imagesystem"
 	"github.com/acorn-io/acorn/pkg/scheme"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/apps"
+	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/auditentries"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/builders"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/builds"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/containers"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/credentials"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/images"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/info"
+	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/projectmemberships"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/projects"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/secrets"
+	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/upgradewebhooks"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/volumes"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn/volumes/class"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin/computeclass"
+	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin/imagepolicy"
 	"github.com/acorn-io/mink/pkg/serializer"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -27,7 +32,7 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]rest.Storage, error) {
+func Stores(c kclient.WithWatch, nodes kclient.Reader, cfg, localCfg *clientgo.Config, recorder *audit.Recorder) (map[string]rest.Storage, error) {
 	clientFactory, err := client.NewClientFactory(localCfg)
 	if err != nil {
 		return nil, err
@@ -54,7 +59,12 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		return nil, err
 	}
 
-	appsStorage := apps.NewStorage(c, clientFactory)
+	containerPortForward, err := containers.NewContainerPortForward(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	appsStorage := apps.NewStorage(c, nodes, clientFactory, transport)
 
 	logsStorage, err := apps.NewLogs(c, cfg)
 	if err != nil {
@@ -64,35 +74,43 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 	volumesStorage := volumes.NewStorage(c)
 
 	stores := map[string]rest.Storage{
-		"acornimagebuilds":       buildsStorage,
-		"apps":                   appsStorage,
-		"apps/log":               logsStorage,
-		"apps/confirmupgrade":    apps.NewConfirmUpgrade(c),
-		"apps/pullimage":         apps.NewPullAppImage(c),
-		"builders":               buildersStorage,
-		"builders/port":          buildersPort,
-		"images":                 imagesStorage,
-		"images/tag":             images.NewTagStorage(c),
-		"images/push":            images.NewImagePush(c, transport),
-		"images/pull":            images.NewImagePull(c, clientFactory, transport),
-		"images/details":         images.NewImageDetails(c, transport),
-		"projects":               projects.NewStorage(c),
-		"volumes":                volumesStorage,
-		"volumeclasses":          class.NewClassStorage(c),
-		"containerreplicas":      containersStorage,
-		"containerreplicas/exec": containerExec,
-		"credentials":            credentials.NewStore(c),
-		"secrets":                secrets.NewStorage(c),
-		"secrets/reveal":         secrets.NewReveal(c),
-		"infos":                  info.NewStorage(c),
-		"computeclasses":         computeclass.NewAggregateStorage(c),
+		"acornimagebuilds":              buildsStorage,
+		"apps":                          appsStorage,
+		"apps/log":                      logsStorage,
+		"apps/confirmupgrade":           apps.NewConfirmUpgrade(c),
+		"apps/pullimage":                apps.NewPullAppImage(c),
+		"builders":                      buildersStorage,
+		"builders/port":                 buildersPort,
+		"images":                        imagesStorage,
+		"images/tag":                    images.NewTagStorage(c),
+		"images/signature":              images.NewSignatureStorage(c, transport),
+		"images/scan":                   images.NewScanStorage(c, transport),
+		"images/push":                   images.NewImagePush(c, transport),
+		"images/pull":                   images.NewImagePull(c, clientFactory, transport),
+		"images/details":                images.NewImageDetails(c, transport),
+		"imageprunes":                   images.NewPruneStorage(c, transport),
+		"projects":                      projects.NewStorage(c),
+		"projectmemberships":            projectmemberships.NewStorage(c),
+		"volumes":                       volumesStorage,
+		"volumeclasses":                 class.NewClassStorage(c),
+		"containerreplicas":             containersStorage,
+		"containerreplicas/exec":        containerExec,
+		"containerreplicas/portforward": containerPortForward,
+		"credentials":                   credentials.NewStore(c),
+		"secrets":                       secrets.NewStorage(c),
+		"secrets/reveal":                secrets.NewReveal(c),
+		"infos":                         info.NewStorage(c),
+		"computeclasses":                computeclass.NewAggregateStorage(c),
+		"imagepolicies":                 imagepolicy.NewAggregateStorage(c),
+		"upgradewebhooks":               upgradewebhooks.NewStorage(c),
+		"auditentries":                  auditentries.NewStorage(c, recorder),
 	}
 
 	return stores, nil
 }
 
-func APIGroup(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (*genericapiserver.APIGroupInfo, error) {
-	stores, err := Stores(c, cfg, localCfg)
+func APIGroup(c kclient.WithWatch, nodes kclient.Reader, cfg, localCfg *clientgo.Config, recorder *audit.Recorder) (*genericapiserver.APIGroupInfo, error) {
+	stores, err := Stores(c, nodes, cfg, localCfg, recorder)
 	if err != nil {
 		return nil, err
 	}
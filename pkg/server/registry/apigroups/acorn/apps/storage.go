@@ -1,6 +1,8 @@
 package apps
 
 import (
+	"net/http"
+
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/client"
 	"github.com/acorn-io/acorn/pkg/tables"
@@ -10,9 +12,9 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch, clientFactory *client.Factory) rest.Storage {
+func NewStorage(c kclient.WithWatch, nodes kclient.Reader, clientFactory *client.Factory, transport http.RoundTripper) rest.Storage {
 	remoteResource := remote.NewWithSimpleTranslation(&Translator{}, &apiv1.App{}, c)
-	validator := NewValidator(c, clientFactory)
+	validator := NewValidator(c, nodes, clientFactory, transport)
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.App{}).
 		WithCreate(remoteResource).
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
@@ -12,18 +13,26 @@ import (
 	"github.com/acorn-io/acorn/pkg/autoupgrade"
 	"github.com/acorn-io/acorn/pkg/client"
 	apiv1config "github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
+	"github.com/acorn-io/acorn/pkg/imagescan"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
+	"github.com/acorn-io/acorn/pkg/podsecurity"
 	"github.com/acorn-io/acorn/pkg/pullsecret"
+	"github.com/acorn-io/acorn/pkg/quota"
 	"github.com/acorn-io/acorn/pkg/tags"
 	"github.com/acorn-io/acorn/pkg/volume"
 	"github.com/acorn-io/baaah/pkg/merr"
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"golang.org/x/exp/slices"
 	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,20 +40,57 @@ import (
 
 type Validator struct {
 	client        kclient.Client
+	nodes         kclient.Reader
 	clientFactory *client.Factory
+	transportOpt  remote.Option
 }
 
-func NewValidator(client kclient.Client, clientFactory *client.Factory) *Validator {
+// NewValidator returns a validator of apps. Nodes are read from nodes to compute the quota usage of per node containers,
+// which should be backed by a cache.
+func NewValidator(client kclient.Client, nodes kclient.Reader, clientFactory *client.Factory, transport http.RoundTripper) *Validator {
 	return &Validator{
 		client:        client,
+		nodes:         nodes,
 		clientFactory: clientFactory,
+		transportOpt:  remote.WithTransport(transport),
 	}
 }
 
 func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
-	params := obj.(*apiv1.App)
+	return s.validate(ctx, obj.(*apiv1.App), nil)
+}
+
+// validate validates the app, and if oldParams is not nil, that the changes to the existing app are allowed
+func (s *Validator) validate(ctx context.Context, params, oldParams *apiv1.App) (result field.ErrorList) {
+	if err := autoupgrade.ValidateUpgradePolicy(params.Spec.UpgradePolicy); err != nil {
+		result = append(result, field.Invalid(field.NewPath("spec", "upgradePolicy"), params.Spec.UpgradePolicy, err.Error()))
+		return
+	}
+
+	if pattern, isPattern := autoupgrade.AutoUpgradePattern(params.Spec.Image); isPattern {
+		if autoupgrade.IsSemverConstraint(pattern) {
+			if _, err := autoupgrade.ParseSemverConstraint(pattern); err != nil {
+				result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
+				return
+			}
+		}
+
+		// The image of the app is resolved by the controller, which checks the quota again when it pulls the image.
+		// Until then the app counts toward the apps of the project along with the image it already runs, if any.
+		status := params.Status
+		if oldParams != nil {
+			status = oldParams.Status
+		}
+		if errs := s.checkQuota(ctx, params, &status.AppSpec, status.Scheduling); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+	} else {
+		if err := imagepolicy.CheckImageAllowed(ctx, s.client, params.Namespace, params.Spec.Image); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
+			return
+		}
 
-	if _, isPattern := autoupgrade.AutoUpgradePattern(params.Spec.Image); !isPattern {
 		image, local, err := s.resolveLocalImage(ctx, params.Namespace, params.Spec.Image)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
@@ -64,29 +110,67 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			return
 		}
 
+		if err := imagesignature.VerifyImage(ctx, s.client, params.Namespace, image, imageDetails.AppImage.Digest, s.transportOpt); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
+			return
+		}
+
+		// A pending scan doesn't block the app, the controller waits for the scan before it pulls the image
+		if err := imagescan.CheckImage(ctx, s.client, params.Namespace, image, imageDetails.AppImage.Digest, s.transportOpt); err != nil && !errors.Is(err, imagescan.ErrScanPending) {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
+			return
+		}
+
 		workloadsFromImage, err := s.getWorkloads(imageDetails)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
 			return
 		}
 
-		apiv1cfg, err := apiv1config.Get(ctx, s.client)
+		apiv1cfg, err := apiv1config.GetForProject(ctx, s.client, params.Namespace)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("config"), params.Spec.Image, err.Error()))
 			return
 		}
 
-		errs := s.checkScheduling(ctx, params, *apiv1cfg, workloadsFromImage, apiv1cfg.WorkloadMemoryDefault, apiv1cfg.WorkloadMemoryMaximum)
+		scheduling, errs := s.checkScheduling(ctx, params, *apiv1cfg, workloadsFromImage, apiv1cfg.WorkloadMemoryDefault, apiv1cfg.WorkloadMemoryMaximum)
 		if len(errs) != 0 {
 			result = append(result, errs...)
 			return
 		}
 
+		if errs := checkAvailability(imageDetails.AppSpec.Containers); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs := checkStatefulVolumes(params, imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if oldParams != nil {
+			if errs := checkStatefulVolumeChanges(oldParams, params, imageDetails.AppSpec); len(errs) != 0 {
+				result = append(result, errs...)
+				return
+			}
+		}
+
+		if errs := checkSecurityContexts(podsecurity.Profile(apiv1cfg), workloadsFromImage); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
 		if err := volume.ValidateVolumeClasses(ctx, s.client, params.Namespace, params.Spec, imageDetails.AppSpec); err != nil {
 			result = append(result, err)
 			return
 		}
 
+		if errs := s.checkQuota(ctx, params, imageDetails.AppSpec, scheduling); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
 		permsFromImage, err := s.getPermissions(imageDetails)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("spec", "permissions"), params.Spec.Permissions, err.Error()))
@@ -107,8 +191,7 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 }
 
 func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) (result field.ErrorList) {
-	newParams := obj.(*apiv1.App)
-	return s.Validate(ctx, newParams)
+	return s.validate(ctx, obj.(*apiv1.App), old.(*apiv1.App))
 }
 
 func (s *Validator) checkRemoteAccess(ctx context.Context, namespace, image string) error {
@@ -256,13 +339,17 @@ func (s *Validator) checkRequestedPermsSatisfyImagePerms(perms []v1.Permissions,
 
 	permsError := &client.ErrRulesNeeded{Permissions: []v1.Permissions{}}
 	for _, perm := range perms {
-		if len(perm.ClusterRules) == 0 && len(perm.Rules) == 0 {
+		if len(perm.ClusterRules) == 0 && len(perm.Rules) == 0 && len(perm.Egress) == 0 &&
+			len(perm.Capabilities) == 0 && !perm.SeccompUnconfined && !perm.PerNode {
 			continue
 		}
 
-		if specPerms := v1.FindPermission(perm.ServiceName, requestedPerms); !specPerms.HasRules() ||
+		if specPerms := v1.FindPermission(perm.ServiceName, requestedPerms); !equality.Semantic.DeepEqual(perm.Rules, specPerms.Get().Rules) ||
 			!equality.Semantic.DeepEqual(perm.ClusterRules, specPerms.Get().ClusterRules) ||
-			!equality.Semantic.DeepEqual(perm.ClusterRules, specPerms.Get().ClusterRules) {
+			!equality.Semantic.DeepEqual(perm.Egress, specPerms.Get().Egress) ||
+			!equality.Semantic.DeepEqual(perm.Capabilities, specPerms.Get().Capabilities) ||
+			perm.SeccompUnconfined != specPerms.SeccompUnconfined ||
+			perm.PerNode != specPerms.PerNode {
 			permsError.Permissions = append(permsError.Permissions, perm)
 			continue
 		}
@@ -310,10 +397,13 @@ func (s *Validator) checkPermissionsForPrivilegeEscalation(ctx context.Context,
 	return merr.NewErrors(errs...)
 }
 
-func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, cfg apiv1.Config, workloads map[string]v1.Container, specMemDefault, specMemMaximum *int64) []*field.Error {
+// checkScheduling validates the memory and compute classes of the workloads and returns their computed scheduling
+// requirements
+func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, cfg apiv1.Config, workloads map[string]v1.Container, specMemDefault, specMemMaximum *int64) (map[string]v1.Scheduling, []*field.Error) {
 	var (
 		memory       = params.Spec.Memory
 		computeClass = params.Spec.ComputeClass
+		scheduling   = make(map[string]v1.Scheduling, len(workloads))
 	)
 	validationErrors := []*field.Error{}
 	err := validateMemoryRunFlags(memory, workloads)
@@ -357,6 +447,12 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, cfg
 			validationErrors = append(validationErrors, field.Invalid(path, memQuantity.String(), err.Error()))
 		}
 
+		requirements := corev1.ResourceRequirements{Requests: corev1.ResourceList{}}
+		if memQuantity.Value() != 0 {
+			requirements.Requests[corev1.ResourceMemory] = memQuantity
+		}
+		scheduling[workload] = v1.Scheduling{Requirements: requirements}
+
 		// Need a ComputeClass to validate it
 		if wc == nil {
 			continue
@@ -371,9 +467,207 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, cfg
 			} else {
 				validationErrors = append(validationErrors, field.Invalid(field.NewPath("unknown"), "", err.Error()))
 			}
+			continue
+		}
+
+		if cpuQuantity, err := adminv1.CalculateCPU(*wc, specMemDefault, memQuantity); err == nil && cpuQuantity.Value() != 0 {
+			requirements.Requests[corev1.ResourceCPU] = cpuQuantity
 		}
 	}
-	return validationErrors
+	return scheduling, validationErrors
+}
+
+// checkAvailability validates the disruption budgets, topology spreads and replica modes of the containers
+func checkAvailability(containers map[string]v1.Container) (result field.ErrorList) {
+	for _, entry := range typed.Sorted(containers) {
+		if maxUnavailable := entry.Value.MaxUnavailable; maxUnavailable != nil {
+			if value, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, 100, true); err != nil || value < 0 {
+				result = append(result, field.Invalid(field.NewPath("spec", "image"), maxUnavailable.String(),
+					fmt.Sprintf("invalid maxUnavailable of %s: must be a non-negative number or percentage", entry.Key)))
+			}
+		}
+		for _, spread := range entry.Value.Spread {
+			if spread.Topology == "" || spread.MaxSkew < 0 {
+				result = append(result, field.Invalid(field.NewPath("spec", "image"), entry.Key,
+					"invalid spread: topology must be set and maxSkew must not be negative"))
+			}
+		}
+		if entry.Value.PerNode && (entry.Value.Scale != nil || len(entry.Value.Spread) > 0 || entry.Value.Stateful) {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), entry.Key,
+				"invalid perNode: scale, spread and stateful can not be set on a container that runs on every node"))
+		}
+	}
+	return result
+}
+
+// checkStatefulVolumes validates that the volumes that each replica of a stateful container has its own copy of are not
+// used by other containers or jobs. Bound and ephemeral volumes are shared by the replicas and can be used by others.
+func checkStatefulVolumes(params *apiv1.App, appSpec *v1.AppSpec) (result field.ErrorList) {
+	bound := map[string]bool{}
+	for _, binding := range params.Spec.Volumes {
+		if binding.Volume != "" {
+			bound[binding.Target] = true
+		}
+	}
+
+	users := map[string][]string{}
+	for _, workloads := range []map[string]v1.Container{appSpec.Containers, appSpec.Jobs} {
+		for _, entry := range typed.Sorted(workloads) {
+			for _, volume := range volumesOf(entry.Value) {
+				users[volume] = append(users[volume], entry.Key)
+			}
+		}
+	}
+
+	for _, entry := range typed.Sorted(appSpec.Containers) {
+		if !entry.Value.Stateful {
+			continue
+		}
+		for _, volume := range volumesOf(entry.Value) {
+			if bound[volume] || strings.EqualFold(appSpec.Volumes[volume].Class, v1.VolumeRequestTypeEphemeral) {
+				continue
+			}
+			for _, user := range users[volume] {
+				if user != entry.Key {
+					result = append(result, field.Invalid(field.NewPath("spec", "image"), entry.Key,
+						fmt.Sprintf("volume %s of stateful container %s can not be used by %s", volume, entry.Key, user)))
+				}
+			}
+		}
+	}
+	return result
+}
+
+// checkStatefulVolumeChanges validates that the volumes that each replica of a stateful container has its own copy of
+// are not added, removed, resized, or moved to another class or access mode. The volume claim templates of a
+// StatefulSet can't be changed once it is created.
+func checkStatefulVolumeChanges(oldParams, params *apiv1.App, appSpec *v1.AppSpec) (result field.ErrorList) {
+	oldAppSpec := &oldParams.Status.AppSpec
+	for _, entry := range typed.Sorted(appSpec.Containers) {
+		oldContainer, ok := oldAppSpec.Containers[entry.Key]
+		if !ok || !oldContainer.Stateful || !entry.Value.Stateful {
+			continue
+		}
+		// The defaults of the existing app are used for both, as they are only recorded once the app is deployed
+		oldVolumes := statefulVolumeRequests(oldParams.Spec.Volumes, oldAppSpec, oldParams.Status.Defaults, oldContainer)
+		newVolumes := statefulVolumeRequests(params.Spec.Volumes, appSpec, oldParams.Status.Defaults, entry.Value)
+		if !equality.Semantic.DeepEqual(oldVolumes, newVolumes) {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), entry.Key,
+				fmt.Sprintf("the volumes of stateful container %s can not be added, removed, resized or changed to another class or access mode", entry.Key)))
+		}
+	}
+	return result
+}
+
+// statefulVolumeRequests returns the class, size and access modes of the volumes that each replica of a stateful
+// container has its own copy of, which are copied into the volume claim templates of its StatefulSet
+func statefulVolumeRequests(bindings []v1.VolumeBinding, appSpec *v1.AppSpec, defaults v1.Defaults, container v1.Container) map[string]v1.VolumeRequest {
+	result := map[string]v1.VolumeRequest{}
+	for _, vol := range volumesOf(container) {
+		var binding v1.VolumeBinding
+		for _, b := range bindings {
+			if b.Target == vol {
+				binding = b
+			}
+		}
+		if binding.Volume != "" {
+			continue
+		}
+		request := volume.CopyVolumeDefaults(appSpec.Volumes[vol], binding, defaults.Volumes[vol])
+		if strings.EqualFold(request.Class, v1.VolumeRequestTypeEphemeral) {
+			continue
+		}
+		result[vol] = v1.VolumeRequest{
+			Class:       request.Class,
+			Size:        request.Size,
+			AccessModes: request.AccessModes,
+		}
+	}
+	return result
+}
+
+// volumesOf returns the names of the volumes that a container and its sidecars mount
+func volumesOf(container v1.Container) (result []string) {
+	for _, c := range append([]v1.Container{container}, typed.SortedValues(container.Sidecars)...) {
+		for _, dir := range typed.SortedValues(c.Dirs) {
+			if dir.ContextDir == "" && dir.Secret.Name == "" && dir.Volume != "" && !slices.Contains(result, dir.Volume) {
+				result = append(result, dir.Volume)
+			}
+		}
+	}
+	return result
+}
+
+// checkSecurityContexts validates the security contexts of the workloads and checks that they are allowed by the pod
+// security profile of the project
+func checkSecurityContexts(profile string, workloads map[string]v1.Container) (result field.ErrorList) {
+	for _, entry := range typed.Sorted(workloads) {
+		if err := podsecurity.Check(profile, entry.Value.SecurityContext); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), entry.Key, err.Error()))
+		}
+	}
+	return result
+}
+
+// checkQuota validates that the app does not take the project over its quota. The usage of the app is computed from
+// the scheduling requirements of its workloads and the defaults of its volumes.
+func (s *Validator) checkQuota(ctx context.Context, params *apiv1.App, appSpec *v1.AppSpec, scheduling map[string]v1.Scheduling) (result field.ErrorList) {
+	projectQuota, err := quota.ForProject(ctx, s.client, params.Namespace)
+	if err != nil {
+		return append(result, field.Invalid(field.NewPath("metadata", "namespace"), params.Namespace, err.Error()))
+	}
+	if projectQuota == nil {
+		return nil
+	}
+
+	app := (*v1.AppInstance)(params.DeepCopy())
+	app.Status.AppSpec = *appSpec
+	app.Status.Scheduling = scheduling
+	app.Status.Defaults.Volumes, err = s.volumeDefaults(ctx, app)
+	if err != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "volumes"), params.Spec.Volumes, err.Error()))
+	}
+
+	exceeded, err := quota.Check(ctx, s.client, s.nodes, projectQuota, app)
+	if err != nil {
+		return append(result, field.Invalid(field.NewPath("spec"), params.Spec.Image, err.Error()))
+	}
+	for _, msg := range exceeded {
+		result = append(result, field.Forbidden(field.NewPath("spec"), msg))
+	}
+	return result
+}
+
+// volumeDefaults returns the defaults of the volumes of the app from the volume classes of the project, the same way
+// the defaults controller computes them once the app is created
+func (s *Validator) volumeDefaults(ctx context.Context, app *v1.AppInstance) (map[string]v1.VolumeDefault, error) {
+	if len(app.Status.AppSpec.Volumes) == 0 {
+		return nil, nil
+	}
+
+	volumeClasses, defaultVolumeClass, err := volume.GetVolumeClasses(ctx, s.client, app.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := volume.SliceToMap(app.Spec.Volumes, func(vb v1.VolumeBinding) string {
+		return vb.Target
+	})
+
+	result := make(map[string]v1.VolumeDefault, len(app.Status.AppSpec.Volumes))
+	for name, vol := range app.Status.AppSpec.Volumes {
+		var volDefaults v1.VolumeDefault
+		vol = volume.CopyVolumeDefaults(vol, bindings[name], volDefaults)
+		if vol.Class == "" && defaultVolumeClass != nil {
+			volDefaults.Class = defaultVolumeClass.Name
+			vol.Class = volDefaults.Class
+		}
+		if vol.Size == "" {
+			volDefaults.Size = volumeClasses[vol.Class].Size.Default
+		}
+		result[name] = volDefaults
+	}
+	return result, nil
 }
 
 func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Container) []*field.Error {
@@ -391,8 +685,8 @@ func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Contain
 }
 
 func (s *Validator) getPermissions(details *client.ImageDetails) (result []v1.Permissions, _ error) {
-	result = append(result, buildPermissionsFrom(details.AppSpec.Containers)...)
-	result = append(result, buildPermissionsFrom(details.AppSpec.Jobs)...)
+	result = append(result, buildPermissionsFrom(details.AppSpec.Containers, details.AppSpec.Egress)...)
+	result = append(result, buildPermissionsFrom(details.AppSpec.Jobs, details.AppSpec.Egress)...)
 
 	return result, nil
 }
@@ -412,18 +706,29 @@ func (s *Validator) getWorkloads(details *client.ImageDetails) (map[string]v1.Co
 	return result, nil
 }
 
-func buildPermissionsFrom(containers map[string]v1.Container) []v1.Permissions {
+// buildPermissionsFrom returns the permissions of the containers and their sidecars. Broad egress rules of the app and
+// the containers, privileged additions to the security contexts of the containers, and containers that run on every
+// node are included as they must be approved like permissions.
+func buildPermissionsFrom(containers map[string]v1.Container, appEgress []v1.EgressRule) []v1.Permissions {
 	permissions := []v1.Permissions{}
 	for _, entry := range typed.Sorted(containers) {
 		entryPermissions := v1.Permissions{
 			ServiceName:  entry.Key,
 			ClusterRules: entry.Value.Permissions.Get().ClusterRules,
 			Rules:        entry.Value.Permissions.Get().Rules,
+			Egress:       append(v1.BroadEgress(appEgress), v1.BroadEgress(entry.Value.Egress)...),
+
+			Capabilities:      podsecurity.AddedCapabilities(entry.Value.SecurityContext),
+			SeccompUnconfined: podsecurity.IsSeccompUnconfined(entry.Value.SecurityContext),
+			PerNode:           entry.Value.PerNode,
 		}
 
 		for _, sidecar := range typed.Sorted(entry.Value.Sidecars) {
 			entryPermissions.ClusterRules = append(entryPermissions.ClusterRules, sidecar.Value.Permissions.Get().ClusterRules...)
 			entryPermissions.Rules = append(entryPermissions.Rules, sidecar.Value.Permissions.Get().Rules...)
+			entryPermissions.Egress = append(entryPermissions.Egress, v1.BroadEgress(sidecar.Value.Egress)...)
+			entryPermissions.Capabilities = append(entryPermissions.Capabilities, podsecurity.AddedCapabilities(sidecar.Value.SecurityContext)...)
+			entryPermissions.SeccompUnconfined = entryPermissions.SeccompUnconfined || podsecurity.IsSeccompUnconfined(sidecar.Value.SecurityContext)
 		}
 
 		permissions = append(permissions, entryPermissions)
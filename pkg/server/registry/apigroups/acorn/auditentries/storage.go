@@ -0,0 +1,17 @@
+package auditentries
+
+import (
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/audit"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/acorn-io/mink/pkg/stores"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewStorage(c client.WithWatch, recorder *audit.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AuditEntry{}).
+		WithList(NewStrategy(recorder)).
+		WithTableConverter(tables.AuditEntryConverter).
+		Build()
+}
@@ -0,0 +1,35 @@
+package auditentries
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/audit"
+	"github.com/acorn-io/mink/pkg/types"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+func NewStrategy(recorder *audit.Recorder) *Strategy {
+	return &Strategy{
+		recorder: recorder,
+	}
+}
+
+// Strategy lists the recent entries recorded by this API server, entries are not persisted in the cluster
+type Strategy struct {
+	recorder *audit.Recorder
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return &apiv1.AuditEntryList{}
+}
+
+func (s *Strategy) New() types.Object {
+	return &apiv1.AuditEntry{}
+}
+
+func (s *Strategy) List(ctx context.Context, namespace string, options storage.ListOptions) (types.ObjectList, error) {
+	return &apiv1.AuditEntryList{
+		Items: s.recorder.Recent(namespace),
+	}, nil
+}
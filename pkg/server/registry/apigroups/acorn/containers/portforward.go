@@ -0,0 +1,100 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imagesystem"
+	"github.com/acorn-io/acorn/pkg/k8sclient"
+	"github.com/acorn-io/baaah/pkg/restconfig"
+	"github.com/acorn-io/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	_ registryrest.Connecter = (*ContainerPortForward)(nil)
+)
+
+// ContainerPortForward proxies a websocket port forward to a port of the pod of a container replica. Each connection
+// forwards a single stream.
+type ContainerPortForward struct {
+	*strategy.DestroyAdapter
+	client     kclient.WithWatch
+	t          *Translator
+	proxy      httputil.ReverseProxy
+	RESTClient rest.Interface
+}
+
+func NewContainerPortForward(client kclient.WithWatch, cfg *rest.Config) (*ContainerPortForward, error) {
+	cfg = rest.CopyConfig(cfg)
+	restconfig.SetScheme(cfg, scheme.Scheme)
+
+	k8s, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := rest.TransportFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerPortForward{
+		t: &Translator{
+			client: client,
+		},
+		client: client,
+		proxy: httputil.ReverseProxy{
+			FlushInterval: 200 * time.Millisecond,
+			Transport:     transport,
+			Director:      func(request *http.Request) {},
+		},
+		RESTClient: k8s.CoreV1().RESTClient(),
+	}, nil
+}
+
+func (c *ContainerPortForward) New() runtime.Object {
+	return &apiv1.ContainerReplicaPortForwardOptions{}
+}
+
+func (c *ContainerPortForward) Connect(ctx context.Context, id string, options runtime.Object, r registryrest.Responder) (http.Handler, error) {
+	opts := options.(*apiv1.ContainerReplicaPortForwardOptions)
+	if opts.Port <= 0 || opts.Port > 65535 {
+		return nil, fmt.Errorf("invalid port %d", opts.Port)
+	}
+
+	container := &apiv1.ContainerReplica{}
+	ns, _ := request.NamespaceFrom(ctx)
+	ns, name, err := c.t.FromPublicName(ctx, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.client.Get(ctx, k8sclient.ObjectKey{Namespace: ns, Name: name}, container)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		request.URL = imagesystem.URLForPortAndPod(c.RESTClient, container.Status.PodNamespace, container.Status.PodName, opts.Port)
+		c.proxy.ServeHTTP(writer, request)
+	}), nil
+}
+
+func (c *ContainerPortForward) NewConnectOptions() (runtime.Object, bool, string) {
+	return &apiv1.ContainerReplicaPortForwardOptions{}, false, ""
+}
+
+func (c *ContainerPortForward) ConnectMethods() []string {
+	return []string{"GET"}
+}
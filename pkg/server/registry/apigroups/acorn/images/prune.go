@@ -0,0 +1,50 @@
+package images
+
+import (
+	"context"
+	"net/http"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imageprune"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewPruneStorage(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImagePrune{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&PruneStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type PruneStrategy struct {
+	client    client.WithWatch
+	remoteOpt remote.Option
+}
+
+func (s *PruneStrategy) New() types.Object {
+	return &apiv1.ImagePrune{}
+}
+
+func (s *PruneStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	prune := obj.(*apiv1.ImagePrune)
+	ns, _ := request.NamespaceFrom(ctx)
+
+	pruned, err := imageprune.Prune(ctx, s.client, ns, prune.DryRun, s.remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	prune.Images = nil
+	for _, image := range pruned {
+		prune.Images = append(prune.Images, apiv1.Image(image))
+	}
+	return prune, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
 	"github.com/acorn-io/acorn/pkg/imagesystem"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -133,6 +134,16 @@ func (i *ImagePull) ImagePull(ctx context.Context, namespace, imageName string,
 	if err != nil {
 		return nil, err
 	}
+	if !externalRepo {
+		if err := imagesystem.CheckRegistryWritable(ctx, i.client); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep any signatures of the image so that they can be verified when the local image is run
+	if err := imagesignature.Copy(pullTag.Context(), repo, hash.String(), opts...); err != nil {
+		return nil, err
+	}
 
 	recordRepo := ""
 	if externalRepo {
@@ -169,6 +180,7 @@ func (i *ImagePull) ImagePull(ctx context.Context, namespace, imageName string,
 				},
 				Repo:   recordRepo,
 				Digest: hash.String(),
+				Source: pullTag.Name(),
 			}
 			if err := i.client.Create(ctx, img); err != nil && !apierror.IsAlreadyExists(err) {
 				progress2 <- ggcrv1.Update{
@@ -10,6 +10,7 @@ import (
 	api "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
 	"github.com/acorn-io/acorn/pkg/imagesystem"
 	"github.com/acorn-io/acorn/pkg/k8schannel"
 	"github.com/acorn-io/baaah/pkg/router"
@@ -161,6 +162,11 @@ func (i *ImagePush) ImagePush(ctx context.Context, image *apiv1.Image, tagName s
 		return nil, nil, err
 	}
 
+	// Signatures are pushed along with the image so that they can be verified from the remote registry
+	if err := imagesignature.Copy(repo, pushTag.Context(), image.Digest, opts...); err != nil {
+		return nil, nil, err
+	}
+
 	progress := make(chan ggcrv1.Update)
 	opts = append(opts, remote.WithProgress(progress))
 	go func() {
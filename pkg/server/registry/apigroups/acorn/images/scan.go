@@ -0,0 +1,46 @@
+package images
+
+import (
+	"context"
+	"net/http"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imagescan"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewScanStorage(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageScan{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ScanStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ScanStrategy struct {
+	client    client.WithWatch
+	remoteOpt remote.Option
+}
+
+func (s *ScanStrategy) New() types.Object {
+	return &apiv1.ImageScan{}
+}
+
+func (s *ScanStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	scan := obj.(*apiv1.ImageScan)
+	if scan.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			scan.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+	return imagescan.ScanImage(ctx, s.client, ns, scan.Name, s.remoteOpt)
+}
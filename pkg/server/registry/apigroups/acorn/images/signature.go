@@ -0,0 +1,103 @@
+package images
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/images"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
+	"github.com/acorn-io/acorn/pkg/imagesystem"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewSignatureStorage(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageSignature{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&SignatureStrategy{
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type SignatureStrategy struct {
+	client       client.WithWatch
+	transportOpt remote.Option
+}
+
+func (s *SignatureStrategy) New() types.Object {
+	return &apiv1.ImageSignature{}
+}
+
+func (s *SignatureStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	sig := obj.(*apiv1.ImageSignature)
+
+	image := &v1.ImageInstance{}
+	if err := s.client.Get(ctx, router.Key(sig.Namespace, sig.Name), image); err != nil {
+		return nil, err
+	}
+
+	payload, err := imagesignature.ParsePayload(sig.Payload)
+	if err != nil {
+		return nil, invalidSignature(image.Name, "payload", err.Error())
+	}
+	if payload.Critical.Image.DockerManifestDigest != image.Digest {
+		return nil, invalidSignature(image.Name, "payload", "signed digest "+payload.Critical.Image.DockerManifestDigest+
+			" does not match image digest "+image.Digest)
+	}
+	if len(sig.Signature) == 0 {
+		return nil, invalidSignature(image.Name, "signature", "signature is required")
+	}
+
+	repo, _, err := imagesystem.GetInternalRepoForNamespace(ctx, s.client, image.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, s.client, image.Namespace, s.transportOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = imagesignature.Append(repo, image.Digest, imagesignature.Signature{
+		Payload:   sig.Payload,
+		Signature: sig.Signature,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ImageSignature{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      image.Name,
+			Namespace: image.Namespace,
+		},
+		Payload:   sig.Payload,
+		Signature: sig.Signature,
+	}, nil
+}
+
+func invalidSignature(name, fieldName, detail string) error {
+	return apierrors.NewInvalid(schema.GroupKind{
+		Group: api.Group,
+		Kind:  "ImageSignature",
+	}, name, field.ErrorList{
+		{
+			Type:   field.ErrorTypeInvalid,
+			Field:  fieldName,
+			Detail: detail,
+		},
+	})
+}
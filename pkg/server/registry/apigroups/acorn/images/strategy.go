@@ -13,6 +13,7 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy"
 	"github.com/acorn-io/mink/pkg/types"
 	"github.com/google/go-containerregistry/pkg/name"
+	"golang.org/x/exp/slices"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -113,6 +114,11 @@ func (s *Strategy) Update(ctx context.Context, obj types.Object) (types.Object,
 	if apierrors.IsNotFound(err) {
 		return image, err
 	}
+	for _, tag := range oldImage.Tags {
+		if !slices.Contains(image.Tags, tag) {
+			recordTagRepository(oldImage, tag)
+		}
+	}
 	oldImage.Tags = image.Tags
 
 	return image, s.client.Update(ctx, oldImage)
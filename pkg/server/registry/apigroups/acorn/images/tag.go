@@ -5,6 +5,7 @@ import (
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/types"
@@ -95,6 +96,7 @@ func (t *TagStrategy) ImageTag(ctx context.Context, namespace, imageName string,
 		for i, tag := range res {
 			if set.Has(tag) {
 				img.Tags = append(img.Tags[:i], img.Tags[i+1:]...)
+				recordTagRepository(&img, tag)
 				hasChanged = true
 			}
 		}
@@ -122,3 +124,16 @@ func normalizeTags(tags []string) ([]string, error) {
 	}
 	return result, nil
 }
+
+// recordTagRepository remembers the repository of a tag that is removed from the image, so that the image is still
+// grouped with the other images of the repository when images are pruned
+func recordTagRepository(image *v1.ImageInstance, tag string) {
+	ref, err := name.NewTag(tag, name.WithDefaultRegistry(""))
+	if err != nil {
+		return
+	}
+	if image.Annotations == nil {
+		image.Annotations = map[string]string{}
+	}
+	image.Annotations[labels.AcornImageTagRepository] = ref.Context().Name()
+}
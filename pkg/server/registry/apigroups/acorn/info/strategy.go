@@ -45,7 +45,7 @@ func (s *Strategy) List(ctx context.Context, namespace string, options storage.L
 		}
 	}
 
-	i, err := info.Get(ctx, s.client)
+	i, err := info.Get(ctx, s.client, ns)
 	if err != nil {
 		return nil, err
 	}
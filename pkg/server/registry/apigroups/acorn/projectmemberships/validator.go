@@ -0,0 +1,127 @@
+package projectmemberships
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/projectmembership"
+	"github.com/acorn-io/acorn/pkg/roles"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Validator struct {
+	client kclient.Client
+}
+
+func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	membership := obj.(*apiv1.ProjectMembership)
+	result = append(result, validateSpec(membership)...)
+	if len(result) > 0 {
+		return result
+	}
+
+	ns := &corev1.Namespace{}
+	if err := s.client.Get(ctx, router.Key("", membership.Namespace), ns); err != nil {
+		return append(result, field.InternalError(field.NewPath("metadata", "namespace"), err))
+	} else if ns.Labels[labels.AcornProject] != "true" {
+		return append(result, field.Invalid(field.NewPath("metadata", "namespace"), membership.Namespace, "must be a project"))
+	}
+
+	if err := s.checkAdmin(ctx, membership.Namespace); err != nil {
+		result = append(result, field.Forbidden(field.NewPath("spec", "roles"), err.Error()))
+	}
+	return result
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) (result field.ErrorList) {
+	return s.Validate(ctx, obj)
+}
+
+func validateSpec(membership *apiv1.ProjectMembership) (result field.ErrorList) {
+	specPath := field.NewPath("spec")
+	switch membership.Spec.Kind {
+	case v1.ProjectMemberKindUser, v1.ProjectMemberKindGroup:
+	default:
+		result = append(result, field.NotSupported(specPath.Child("kind"), membership.Spec.Kind,
+			[]string{v1.ProjectMemberKindUser, v1.ProjectMemberKindGroup}))
+	}
+
+	if membership.Spec.Name == "" {
+		result = append(result, field.Required(specPath.Child("name"), "the name of the user or group is required"))
+	} else if expected := projectmembership.Name(membership.Spec.Kind, membership.Spec.Name); membership.Name != expected {
+		result = append(result, field.Invalid(field.NewPath("metadata", "name"), membership.Name,
+			fmt.Sprintf("must be %s, the name of the membership of the %s", expected, strings.ToLower(membership.Spec.Kind))))
+	}
+
+	if len(membership.Spec.Roles) == 0 {
+		result = append(result, field.Required(specPath.Child("roles"), "at least one role is required"))
+	}
+	seen := map[string]bool{}
+	for i, role := range membership.Spec.Roles {
+		if _, ok := roles.ProjectRoles[role]; !ok {
+			result = append(result, field.NotSupported(specPath.Child("roles").Index(i), role, typed.SortedKeys(roles.ProjectRoles)))
+		} else if seen[role] {
+			result = append(result, field.Duplicate(specPath.Child("roles").Index(i), role))
+		}
+		seen[role] = true
+	}
+	return result
+}
+
+// checkAdmin checks that the user making the request holds every rule of the admin role of the project, so that only
+// project admins, or users with more privileges, can manage the members of a project
+func (s *Validator) checkAdmin(ctx context.Context, namespace string) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return fmt.Errorf("failed to find active user to check current privileges")
+	}
+
+	spec := authv1.SubjectAccessReviewSpec{
+		User:   user.GetName(),
+		Groups: user.GetGroups(),
+		Extra:  map[string]authv1.ExtraValue{},
+		UID:    user.GetUID(),
+	}
+	for k, v := range user.GetExtra() {
+		spec.Extra[k] = v
+	}
+
+	for _, rule := range roles.Rules(roles.Admin) {
+		for _, verb := range rule.Verbs {
+			for _, apiGroup := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					resource, subResource, _ := strings.Cut(resource, "/")
+					sar := &authv1.SubjectAccessReview{
+						Spec: *spec.DeepCopy(),
+					}
+					sar.Spec.ResourceAttributes = &authv1.ResourceAttributes{
+						Namespace:   namespace,
+						Verb:        verb,
+						Group:       apiGroup,
+						Version:     "*",
+						Resource:    resource,
+						Subresource: subResource,
+					}
+					if err := s.client.Create(ctx, sar); err != nil {
+						return err
+					}
+					if !sar.Status.Allowed {
+						return fmt.Errorf("managing the members of project %s requires the %s role", namespace, roles.Admin)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -17,14 +17,19 @@ func NewStorage(c kclient.WithWatch) rest.Storage {
 		c:       c,
 		lister:  remoteResource,
 		creater: remoteResource,
+		updater: remoteResource,
 		deleter: remoteResource,
 	}
+	validator := &Validator{client: c}
 	return stores.NewBuilder(c.Scheme(), &apiv1.Project{}).
 		WithCreate(strategy).
 		WithDelete(strategy).
 		WithGet(strategy).
+		WithUpdate(strategy).
 		// Watch is not enabled because the dynamic privileges can do weird things...
 		WithList(strategy).
+		WithValidateCreate(validator).
+		WithValidateUpdate(validator).
 		WithTableConverter(tables.ProjectConverter).
 		Build()
 }
@@ -26,6 +26,7 @@ type Strategy struct {
 	c       kclient.Client
 	creater strategy.Creater
 	lister  strategy.Lister
+	updater strategy.Updater
 	deleter strategy.Deleter
 }
 
@@ -82,6 +83,10 @@ func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Objec
 	}, name)
 }
 
+func (s *Strategy) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	return s.updater.Update(ctx, obj)
+}
+
 func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
 	return s.deleter.Delete(ctx, obj)
 }
@@ -5,6 +5,7 @@ import (
 
 	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/labels"
+	"github.com/acorn-io/acorn/pkg/namespace"
 	"github.com/acorn-io/mink/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -39,9 +40,15 @@ func (t *Translator) ToPublic(ctx context.Context, obj ...runtime.Object) (resul
 		if !ns.DeletionTimestamp.IsZero() {
 			continue
 		}
+		spec, err := namespace.ProjectSpec(ns)
+		if err != nil {
+			return nil, err
+		}
 		delete(ns.Labels, labels.AcornProject)
+		delete(ns.Annotations, labels.AcornProjectSpec)
 		result = append(result, &apiv1.Project{
 			ObjectMeta: ns.ObjectMeta,
+			Spec:       spec,
 			Status: apiv1.ProjectStatus{
 				Namespace: ns.Name,
 			},
@@ -52,14 +59,17 @@ func (t *Translator) ToPublic(ctx context.Context, obj ...runtime.Object) (resul
 
 func (t *Translator) FromPublic(ctx context.Context, obj runtime.Object) (types.Object, error) {
 	prj := obj.(*apiv1.Project)
-	if prj.Labels == nil {
-		prj.Labels = map[string]string{
-			labels.AcornProject: "true",
-		}
+	ns := &corev1.Namespace{
+		ObjectMeta: *prj.ObjectMeta.DeepCopy(),
+	}
+	// The project label is removed by ToPublic, so it is added back for updates
+	ns.Labels = labels.Merge(ns.Labels, map[string]string{
+		labels.AcornProject: "true",
+	})
+	if err := namespace.SetProjectSpec(ns, prj.Spec); err != nil {
+		return nil, err
 	}
-	return &corev1.Namespace{
-		ObjectMeta: prj.ObjectMeta,
-	}, nil
+	return ns, nil
 }
 
 func (t *Translator) NewPublic() types.Object {
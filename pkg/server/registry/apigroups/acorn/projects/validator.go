@@ -0,0 +1,122 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/autoupgrade/validate"
+	"github.com/acorn-io/acorn/pkg/config"
+	"github.com/acorn-io/acorn/pkg/podsecurity"
+	"github.com/acorn-io/acorn/pkg/publish"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Validator struct {
+	client kclient.Reader
+}
+
+func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	project := obj.(*apiv1.Project)
+	result = append(result, validateQuota(field.NewPath("spec", "quota"), project.Spec.Quota)...)
+	result = append(result, s.validateConfig(ctx, field.NewPath("spec", "config"), project.Spec.Config)...)
+	return result
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) (result field.ErrorList) {
+	return s.Validate(ctx, obj)
+}
+
+func (s *Validator) validateConfig(ctx context.Context, path *field.Path, projectConfig *apiv1.ProjectConfig) (result field.ErrorList) {
+	if projectConfig == nil {
+		return nil
+	}
+
+	cfg, err := config.Get(ctx, s.client)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	if locked := config.LockedFields(cfg, projectConfig); len(locked) > 0 {
+		result = append(result, field.Forbidden(path, fmt.Sprintf("fields locked by the cluster config cannot be overridden: %s", strings.Join(locked, ", "))))
+	}
+
+	switch projectConfig.DefaultPublishMode {
+	case "", v1.PublishModeAll, v1.PublishModeNone, v1.PublishModeDefined:
+	default:
+		result = append(result, field.NotSupported(path.Child("defaultPublishMode"), projectConfig.DefaultPublishMode,
+			[]string{string(v1.PublishModeAll), string(v1.PublishModeNone), string(v1.PublishModeDefined)}))
+	}
+	if projectConfig.HttpEndpointPattern != nil {
+		if err := publish.ValidateEndpointPattern(*projectConfig.HttpEndpointPattern); err != nil {
+			result = append(result, field.Invalid(path.Child("httpEndpointPattern"), *projectConfig.HttpEndpointPattern, err.Error()))
+		}
+	}
+	if projectConfig.AutoUpgradeInterval != nil {
+		if _, err := validate.AutoUpgradeInterval(*projectConfig.AutoUpgradeInterval); err != nil {
+			result = append(result, field.Invalid(path.Child("autoUpgradeInterval"), *projectConfig.AutoUpgradeInterval, err.Error()))
+		}
+	}
+	if projectConfig.PodSecurityEnforceProfile != "" {
+		if !podsecurity.IsValid(projectConfig.PodSecurityEnforceProfile) {
+			result = append(result, field.NotSupported(path.Child("podSecurityEnforceProfile"), projectConfig.PodSecurityEnforceProfile, podsecurity.Profiles()))
+		} else if clusterProfile := podsecurity.Profile(cfg); podsecurity.IsStricter(clusterProfile, projectConfig.PodSecurityEnforceProfile) {
+			result = append(result, field.Invalid(path.Child("podSecurityEnforceProfile"), projectConfig.PodSecurityEnforceProfile,
+				fmt.Sprintf("must not be less restrictive than the %s profile of the cluster", clusterProfile)))
+		}
+	}
+	result = append(result, validateMemory(path.Child("workloadMemoryDefault"), projectConfig.WorkloadMemoryDefault)...)
+	result = append(result, validateMemory(path.Child("workloadMemoryMaximum"), projectConfig.WorkloadMemoryMaximum)...)
+
+	// The default must not exceed the maximum, taking the value of the cluster config for the one that is not overridden
+	defaultMemory, maximumMemory := cfg.WorkloadMemoryDefault, cfg.WorkloadMemoryMaximum
+	if projectConfig.WorkloadMemoryDefault != nil {
+		defaultMemory = projectConfig.WorkloadMemoryDefault
+	}
+	if projectConfig.WorkloadMemoryMaximum != nil {
+		maximumMemory = projectConfig.WorkloadMemoryMaximum
+	}
+	if defaultMemory != nil && maximumMemory != nil && *maximumMemory != 0 && *defaultMemory > *maximumMemory {
+		result = append(result, field.Invalid(path.Child("workloadMemoryDefault"), resource.NewQuantity(*defaultMemory, resource.BinarySI).String(),
+			fmt.Sprintf("exceeds the workload memory maximum of %s", resource.NewQuantity(*maximumMemory, resource.BinarySI).String())))
+	}
+	return result
+}
+
+func validateMemory(path *field.Path, value *int64) field.ErrorList {
+	if value != nil && *value < 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must not be negative")}
+	}
+	return nil
+}
+
+func validateQuota(path *field.Path, quota *apiv1.ProjectQuota) (result field.ErrorList) {
+	if quota == nil {
+		return nil
+	}
+	result = append(result, validateCount(path.Child("apps"), quota.Apps)...)
+	result = append(result, validateQuantity(path.Child("memory"), quota.Memory)...)
+	result = append(result, validateQuantity(path.Child("cpu"), quota.CPU)...)
+	result = append(result, validateQuantity(path.Child("volumeStorage"), quota.VolumeStorage)...)
+	result = append(result, validateCount(path.Child("publishedEndpoints"), quota.PublishedEndpoints)...)
+	result = append(result, validateCount(path.Child("containerReplicas"), quota.ContainerReplicas)...)
+	return result
+}
+
+func validateCount(path *field.Path, value *int32) field.ErrorList {
+	if value != nil && *value < 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must not be negative")}
+	}
+	return nil
+}
+
+func validateQuantity(path *field.Path, value *resource.Quantity) field.ErrorList {
+	if value != nil && value.Sign() < 0 {
+		return field.ErrorList{field.Invalid(path, value.String(), "must not be negative")}
+	}
+	return nil
+}
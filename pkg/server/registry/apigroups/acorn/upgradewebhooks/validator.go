@@ -0,0 +1,45 @@
+package upgradewebhooks
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/upgradewebhook"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	return validateSpec(obj.(*apiv1.UpgradeWebhook).Spec)
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, newObj)
+}
+
+func validateSpec(spec v1.UpgradeWebhookSpec) (result field.ErrorList) {
+	specPath := field.NewPath("spec")
+	if err := upgradewebhook.CheckURL(spec.URL); err != nil {
+		result = append(result, field.Invalid(specPath.Child("url"), spec.URL, err.Error()))
+	}
+
+	switch spec.Format {
+	case "", v1.UpgradeWebhookFormatJSON, v1.UpgradeWebhookFormatSlack:
+	default:
+		result = append(result, field.NotSupported(specPath.Child("format"), spec.Format,
+			[]string{v1.UpgradeWebhookFormatJSON, v1.UpgradeWebhookFormatSlack}))
+	}
+
+	for i, event := range spec.Events {
+		switch event {
+		case v1.UpgradeEventAvailable, v1.UpgradeEventApplied:
+		default:
+			result = append(result, field.NotSupported(specPath.Child("events").Index(i), event,
+				[]string{v1.UpgradeEventAvailable, v1.UpgradeEventApplied}))
+		}
+	}
+	return
+}
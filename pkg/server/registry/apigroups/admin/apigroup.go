@@ -5,6 +5,7 @@ import (
 	v1 "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io/v1"
 	"github.com/acorn-io/acorn/pkg/scheme"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin/computeclass"
+	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin/imagepolicy"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin/volumeclass"
 	"github.com/acorn-io/mink/pkg/serializer"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,6 +22,8 @@ func Stores(c kclient.WithWatch) (map[string]rest.Storage, error) {
 		"projectcomputeclasses": computeclass.NewProjectStorage(c),
 		"clustervolumeclasses":  volumeclass.NewClusterStorage(c),
 		"projectvolumeclasses":  volumeclass.NewProjectStorage(c),
+		"clusterimagepolicies":  imagepolicy.NewClusterStorage(c),
+		"projectimagepolicies":  imagepolicy.NewProjectStorage(c),
 	}, nil
 }
 
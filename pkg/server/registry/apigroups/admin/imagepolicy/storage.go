@@ -0,0 +1,43 @@
+package imagepolicy
+
+import (
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io/v1"
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/tables"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewClusterStorage(c kclient.WithWatch) rest.Storage {
+	remoteResource := remote.NewWithSimpleTranslation(&ClusterTranslator{}, &adminv1.ClusterImagePolicy{}, c)
+	validator := &ClusterValidator{}
+
+	return stores.NewBuilder(c.Scheme(), &adminv1.ClusterImagePolicy{}).
+		WithCompleteCRUD(remoteResource).
+		WithValidateUpdate(validator).
+		WithValidateCreate(validator).
+		WithTableConverter(tables.ImagePolicyConverter).
+		Build()
+}
+
+func NewProjectStorage(c kclient.WithWatch) rest.Storage {
+	remoteResource := remote.NewWithSimpleTranslation(&ProjectTranslator{}, &adminv1.ProjectImagePolicy{}, c)
+	validator := &ProjectValidator{}
+
+	return stores.NewBuilder(c.Scheme(), &adminv1.ProjectImagePolicy{}).
+		WithCompleteCRUD(remoteResource).
+		WithValidateUpdate(validator).
+		WithValidateCreate(validator).
+		WithTableConverter(tables.ImagePolicyConverter).
+		Build()
+}
+
+func NewAggregateStorage(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImagePolicy{}).
+		WithGet(NewStrategy(c)).
+		WithList(NewStrategy(c)).
+		WithTableConverter(tables.ActiveImagePolicyConverter).
+		Build()
+}
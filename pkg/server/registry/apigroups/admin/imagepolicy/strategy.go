@@ -0,0 +1,92 @@
+package imagepolicy
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/acorn/pkg/apis/api.acorn.io/v1"
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/mink/pkg/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Strategy struct {
+	client client.WithWatch
+}
+
+func NewStrategy(c client.WithWatch) *Strategy {
+	return &Strategy{client: c}
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return &apiv1.ImagePolicyList{}
+}
+
+func (s *Strategy) New() types.Object {
+	return &apiv1.ImagePolicy{}
+}
+
+func (s *Strategy) List(ctx context.Context, namespace string, _ storage.ListOptions) (types.ObjectList, error) {
+	clusterImagePolicies := &adminv1.ClusterImagePolicyInstanceList{}
+	if err := s.client.List(ctx, clusterImagePolicies); err != nil {
+		return nil, err
+	}
+
+	projectImagePolicies := &adminv1.ProjectImagePolicyInstanceList{}
+	if err := s.client.List(ctx, projectImagePolicies, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	imagePolicies := apiv1.ImagePolicyList{Items: make(
+		[]apiv1.ImagePolicy,
+		0,
+		len(clusterImagePolicies.Items)+len(projectImagePolicies.Items))}
+
+	// Unlike classes, project policies do not take precedence over cluster policies, they all apply.
+	for _, pip := range projectImagePolicies.Items {
+		imagePolicies.Items = append(imagePolicies.Items, apiv1.ImagePolicy{
+			ObjectMeta:             v1.ObjectMeta{Name: pip.Name, Namespace: pip.Namespace, CreationTimestamp: pip.CreationTimestamp},
+			Scope:                  apiv1.ImagePolicyScopeProject,
+			Description:            pip.Description,
+			PublicKeys:             pip.PublicKeys,
+			AllowedImages:          pip.AllowedImages,
+			DeniedImages:           pip.DeniedImages,
+			VulnerabilityThreshold: pip.VulnerabilityThreshold,
+		})
+	}
+
+	for _, cip := range clusterImagePolicies.Items {
+		imagePolicies.Items = append(imagePolicies.Items, apiv1.ImagePolicy{
+			ObjectMeta:             v1.ObjectMeta{Name: cip.Name, CreationTimestamp: cip.CreationTimestamp},
+			Scope:                  apiv1.ImagePolicyScopeCluster,
+			Description:            cip.Description,
+			PublicKeys:             cip.PublicKeys,
+			AllowedImages:          cip.AllowedImages,
+			DeniedImages:           cip.DeniedImages,
+			VulnerabilityThreshold: cip.VulnerabilityThreshold,
+		})
+	}
+
+	return &imagePolicies, nil
+}
+
+func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	list, err := s.List(ctx, namespace, storage.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, imagePolicy := range list.(*apiv1.ImagePolicyList).Items {
+		if imagePolicy.Name == name {
+			return &imagePolicy, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    apiv1.SchemeGroupVersion.Group,
+		Resource: "imagepolicies",
+	}, name)
+}
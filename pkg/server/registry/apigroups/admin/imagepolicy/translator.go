@@ -0,0 +1,25 @@
+package imagepolicy
+
+import (
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io/v1"
+	admininternalv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
+	mtypes "github.com/acorn-io/mink/pkg/types"
+)
+
+type ClusterTranslator struct{}
+
+func (s *ClusterTranslator) FromPublic(obj mtypes.Object) mtypes.Object {
+	return (*admininternalv1.ClusterImagePolicyInstance)(obj.(*adminv1.ClusterImagePolicy))
+}
+func (s *ClusterTranslator) ToPublic(obj mtypes.Object) mtypes.Object {
+	return (*adminv1.ClusterImagePolicy)(obj.(*admininternalv1.ClusterImagePolicyInstance))
+}
+
+type ProjectTranslator struct{}
+
+func (s *ProjectTranslator) FromPublic(obj mtypes.Object) mtypes.Object {
+	return (*admininternalv1.ProjectImagePolicyInstance)(obj.(*adminv1.ProjectImagePolicy))
+}
+func (s *ProjectTranslator) ToPublic(obj mtypes.Object) mtypes.Object {
+	return (*adminv1.ProjectImagePolicy)(obj.(*admininternalv1.ProjectImagePolicyInstance))
+}
@@ -0,0 +1,57 @@
+package imagepolicy
+
+import (
+	"context"
+
+	adminv1 "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io/v1"
+	admininternalv1 "github.com/acorn-io/acorn/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/acorn/pkg/imagepolicy"
+	"github.com/acorn-io/acorn/pkg/imagescan"
+	"github.com/acorn-io/acorn/pkg/imagesignature"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ProjectValidator struct{}
+
+func (s *ProjectValidator) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validateImagePolicy((*admininternalv1.ProjectImagePolicyInstance)(obj.(*adminv1.ProjectImagePolicy)))
+}
+
+func (s *ProjectValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, newObj)
+}
+
+type ClusterValidator struct{}
+
+func (s *ClusterValidator) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validateImagePolicy((*admininternalv1.ProjectImagePolicyInstance)(obj.(*adminv1.ClusterImagePolicy)))
+}
+
+func (s *ClusterValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, newObj)
+}
+
+func validateImagePolicy(policy *admininternalv1.ProjectImagePolicyInstance) (result field.ErrorList) {
+	for i, key := range policy.PublicKeys {
+		if _, err := imagesignature.ParsePublicKey([]byte(key)); err != nil {
+			result = append(result, field.Invalid(field.NewPath("publicKeys").Index(i), key, err.Error()))
+		}
+	}
+	for i, pattern := range policy.AllowedImages {
+		if err := imagepolicy.ValidatePattern(pattern); err != nil {
+			result = append(result, field.Invalid(field.NewPath("allowedImages").Index(i), pattern, err.Error()))
+		}
+	}
+	for i, pattern := range policy.DeniedImages {
+		if err := imagepolicy.ValidatePattern(pattern); err != nil {
+			result = append(result, field.Invalid(field.NewPath("deniedImages").Index(i), pattern, err.Error()))
+		}
+	}
+	if policy.VulnerabilityThreshold != "" {
+		if _, err := imagescan.ParseSeverity(policy.VulnerabilityThreshold); err != nil {
+			result = append(result, field.Invalid(field.NewPath("vulnerabilityThreshold"), policy.VulnerabilityThreshold, err.Error()))
+		}
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"github.com/acorn-io/acorn/pkg/audit"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/acorn"
 	"github.com/acorn-io/acorn/pkg/server/registry/apigroups/admin"
 	genericapiserver "k8s.io/apiserver/pkg/server"
@@ -8,14 +9,16 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-var apiGroupFactories = []APIGroupFunc{
-	admin.APIGroup,
-	acorn.APIGroup,
-}
-
 type APIGroupFunc func(kclient.WithWatch, *clientgo.Config, *clientgo.Config) (*genericapiserver.APIGroupInfo, error)
 
-func APIGroups(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (result []*genericapiserver.APIGroupInfo, err error) {
+func APIGroups(c kclient.WithWatch, nodes kclient.Reader, cfg, localCfg *clientgo.Config, recorder *audit.Recorder) (result []*genericapiserver.APIGroupInfo, err error) {
+	apiGroupFactories := []APIGroupFunc{
+		admin.APIGroup,
+		func(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (*genericapiserver.APIGroupInfo, error) {
+			return acorn.APIGroup(c, nodes, cfg, localCfg, recorder)
+		},
+	}
+
 	for _, factory := range apiGroupFactories {
 		apiGroup, err := factory(c, cfg, localCfg)
 		if err != nil {
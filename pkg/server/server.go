@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 
 	adminapi "github.com/acorn-io/acorn/pkg/apis/admin.acorn.io"
 	api "github.com/acorn-io/acorn/pkg/apis/api.acorn.io"
+	"github.com/acorn-io/acorn/pkg/audit"
 	kclient "github.com/acorn-io/acorn/pkg/k8sclient"
 	openapi2 "github.com/acorn-io/acorn/pkg/openapi"
+	"github.com/acorn-io/acorn/pkg/registrynotify"
 	"github.com/acorn-io/acorn/pkg/scheme"
 	"github.com/acorn-io/acorn/pkg/server/registry"
 	"github.com/acorn-io/baaah/pkg/clientaggregator"
@@ -22,6 +25,7 @@ import (
 	"k8s.io/apiserver/pkg/server/options"
 	"k8s.io/client-go/rest"
 	netutils "k8s.io/utils/net"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 )
 
 type Server struct {
@@ -65,11 +69,6 @@ func (s *Server) Run(ctx context.Context, config *Config) error {
 		return merr.NewErrors(errs...)
 	}
 
-	server, err := config.Complete().New("acorn", server.NewEmptyDelegate())
-	if err != nil {
-		return err
-	}
-
 	cfg, err := restconfig.New(scheme.Scheme)
 	if err != nil {
 		return err
@@ -94,7 +93,28 @@ func (s *Server) Run(ctx context.Context, config *Config) error {
 		c = aggr
 	}
 
-	apiGroups, err := registry.APIGroups(c, cfg, localCfg)
+	recorder := audit.NewRecorder(c)
+	recorder.Start(ctx)
+	config.BuildHandlerChainFunc = func(handler http.Handler, serverConfig *server.Config) http.Handler {
+		return server.DefaultBuildHandlerChain(recorder.Wrap(handler), serverConfig)
+	}
+
+	server, err := config.Complete().New("acorn", server.NewEmptyDelegate())
+	if err != nil {
+		return err
+	}
+
+	// Nodes are only listed to compute the quota usage of per node containers. The cache starts watching them the first
+	// time they are listed, so clusters without per node containers don't watch them.
+	nodes, err := cache.New(cfg, cache.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return err
+	}
+	go func() {
+		_ = nodes.Start(ctx)
+	}()
+
+	apiGroups, err := registry.APIGroups(c, nodes, cfg, localCfg, recorder)
 	if err != nil {
 		return err
 	}
@@ -103,6 +123,8 @@ func (s *Server) Run(ctx context.Context, config *Config) error {
 		return err
 	}
 
+	server.Handler.NonGoRestfulMux.HandlePrefix(registrynotify.PathPrefix, registrynotify.NewHandler(c))
+
 	return server.PrepareRun().Run(ctx.Done())
 }
 
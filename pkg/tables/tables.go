@@ -62,6 +62,12 @@ var (
 	}
 	ImageConverter = MustConverter(Image)
 
+	ImagePrune = [][]string{
+		{"Image-ID", "{{trunc .Name}}"},
+		{"Digest", "{{.Digest}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+
 	ImageContainer = [][]string{
 		{"Repository", "{{ .Repo }}"},
 		{"Tag", "{{ .Tag }}"},
@@ -125,6 +131,54 @@ var (
 	}
 	ComputeClassConverter = MustConverter(ComputeClass)
 
+	ImageDetails = [][]string{
+		{"Name", "{{ .Name }}"},
+		{"Type", "{{ .Type }}"},
+		{"Image", "{{ trunc .Image }}"},
+		{"Platforms", "{{ platforms .Platforms }}"},
+	}
+
+	Vulnerability = [][]string{
+		{"ID", "{{ .ID }}"},
+		{"Severity", "{{ .Severity }}"},
+		{"Package", "{{ .Package }}"},
+		{"Installed", "{{ .InstalledVersion }}"},
+		{"Fixed", "{{ .FixedVersion }}"},
+		{"Title", "{{ .Title }}"},
+	}
+
+	ImagePolicy = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Allowed-Images", "{{ pointer .AllowedImages }}"},
+		{"Denied-Images", "{{ pointer .DeniedImages }}"},
+		{"Public-Keys", "{{ len .PublicKeys }}"},
+		{"Vulnerability-Threshold", "{{ .VulnerabilityThreshold }}"},
+		{"Description", "{{ .Description }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	ImagePolicyConverter = MustConverter(ImagePolicy)
+
+	ActiveImagePolicy = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Scope", "{{ .Scope }}"},
+		{"Allowed-Images", "{{ pointer .AllowedImages }}"},
+		{"Denied-Images", "{{ pointer .DeniedImages }}"},
+		{"Public-Keys", "{{ len .PublicKeys }}"},
+		{"Vulnerability-Threshold", "{{ .VulnerabilityThreshold }}"},
+		{"Description", "{{ .Description }}"},
+	}
+	ActiveImagePolicyConverter = MustConverter(ActiveImagePolicy)
+
+	UpgradeWebhook = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"URL", "{{ .Spec.URL }}"},
+		{"Format", "{{ if .Spec.Format }}{{ .Spec.Format }}{{ else }}json{{ end }}"},
+		{"Events", "{{ if .Spec.Events }}{{ array .Spec.Events }}{{ else }}all{{ end }}"},
+		{"Last-Delivery", "{{ lastDelivery .Status.Deliveries }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	UpgradeWebhookConverter = MustConverter(UpgradeWebhook)
+
 	Build = [][]string{
 		{"Name", "Name"},
 		{"Image", "Status.AppImage.ID"},
@@ -143,6 +197,32 @@ var (
 		{"Default", "{{ boolToStar .Default }}"},
 	}
 
+	ProjectMembership = [][]string{
+		{"Name", "{{ .Spec.Name }}"},
+		{"Kind", "{{ .Spec.Kind }}"},
+		{"Roles", "{{ array .Spec.Roles }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	ProjectMembershipConverter = MustConverter(ProjectMembership)
+
+	ProjectQuota = [][]string{
+		{"Resource", "Resource"},
+		{"Used", "Used"},
+		{"Limit", "Limit"},
+	}
+
+	AuditEntry = [][]string{
+		{"Time", "{{ago .CreationTimestamp}}"},
+		{"User", "User"},
+		{"Verb", "Verb"},
+		{"Resource", "{{ .Resource }}{{ if .Subresource }}/{{ .Subresource }}{{ end }}"},
+		{"Name", "ResourceName"},
+		{"Project", "Project"},
+		{"App", "App"},
+		{"Code", "{{ if .StatusCode }}{{ .StatusCode }}{{ end }}"},
+	}
+	AuditEntryConverter = MustConverter(AuditEntry)
+
 	RuleRequests = [][]string{
 		{"Service", "Service"},
 		{"Verbs", "Verbs"},
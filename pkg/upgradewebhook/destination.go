@@ -0,0 +1,114 @@
+package upgradewebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var errBlockedAddress = errors.New("webhooks can not be sent to this address")
+
+// CheckURL returns an error if the URL is not an absolute http or https URL, or if its host is an IP address that
+// webhooks must not be sent to. Host names are checked when they are resolved for each delivery.
+func CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an absolute http or https URL")
+	}
+	if u.Hostname() == "localhost" {
+		return fmt.Errorf("must not be a loopback address")
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		return checkIP(ip)
+	}
+	return nil
+}
+
+// reservedNetworks are the networks, besides the ones checkIP rejects by kind, that are not part of the public
+// internet or that translate to addresses that may not be
+var reservedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // this network
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved and broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+	netip.MustParsePrefix("100::/64"),        // discard
+	netip.MustParsePrefix("2001::/23"),       // IETF protocol assignments, including Teredo
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("2002::/16"),       // 6to4
+}
+
+// checkIP returns an error if the address is loopback, link-local, private, unspecified, multicast or in one of the
+// reservedNetworks. Webhooks are sent from inside the cluster, so these addresses would let users reach services of
+// the cluster and the cloud metadata endpoint that are not exposed to them. IPv4-mapped IPv6 addresses are checked as
+// the IPv4 address they map.
+func checkIP(ip net.IP) error {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return fmt.Errorf("must be a valid address")
+	}
+	addr = addr.Unmap()
+
+	switch {
+	case addr.IsLoopback():
+		return fmt.Errorf("must not be a loopback address")
+	case addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast():
+		return fmt.Errorf("must not be a link-local address")
+	case addr.IsPrivate():
+		return fmt.Errorf("must not be a private address")
+	case addr.IsUnspecified():
+		return fmt.Errorf("must not be an unspecified address")
+	case addr.IsMulticast():
+		return fmt.Errorf("must not be a multicast address")
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(addr) {
+			return fmt.Errorf("must not be a reserved address")
+		}
+	}
+	return nil
+}
+
+// newHTTPClient returns a client that refuses to connect to the addresses rejected by checkIP, whatever host name
+// resolves to them. It doesn't use a proxy, because the address of the proxy would be checked instead.
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip != nil {
+				if err := checkIP(ip); err != nil {
+					return fmt.Errorf("%w: %s %v", errBlockedAddress, host, err)
+				}
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, addr)
+			},
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,270 @@
+package upgradewebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request body, prefixed with "sha256="
+	SignatureHeader = "X-Acorn-Signature-256"
+	// EventHeader holds the type of the event
+	EventHeader = "X-Acorn-Event"
+	// SecretKey is the key of the webhook secret that holds the signing key
+	SecretKey = "secret"
+
+	maxDeliveries = 20
+	maxAttempts   = 3
+	// notifiedTTL is how long NotifyOnce remembers an event
+	notifiedTTL = time.Hour
+)
+
+var (
+	// retryDelay is the delay before the second attempt of a delivery, it doubles for each further attempt
+	retryDelay = 2 * time.Second
+	httpClient = newHTTPClient()
+
+	notified = struct {
+		sync.Mutex
+		at map[string]time.Time
+	}{at: map[string]time.Time{}}
+)
+
+// Event is the payload sent to webhooks in the json format
+type Event struct {
+	Type          string    `json:"type"`
+	Time          time.Time `json:"time"`
+	Project       string    `json:"project"`
+	App           string    `json:"app"`
+	Image         string    `json:"image"`
+	Digest        string    `json:"digest,omitempty"`
+	PreviousImage string    `json:"previousImage,omitempty"`
+}
+
+// NewEvent returns an event of the given type for the app
+func NewEvent(eventType string, app *v1.AppInstance, image, digest string) Event {
+	return Event{
+		Type:          eventType,
+		Time:          time.Now().UTC(),
+		Project:       app.Namespace,
+		App:           app.Name,
+		Image:         image,
+		Digest:        digest,
+		PreviousImage: app.Status.AppImage.Name,
+	}
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Payload returns the body that is posted to a webhook of the given format
+func Payload(format string, event Event) ([]byte, error) {
+	if format != v1.UpgradeWebhookFormatSlack {
+		return json.Marshal(event)
+	}
+
+	var text string
+	switch event.Type {
+	case v1.UpgradeEventAvailable:
+		text = fmt.Sprintf("An upgrade to *%s* is available for app *%s* in project *%s*", event.Image, event.App, event.Project)
+	case v1.UpgradeEventApplied:
+		text = fmt.Sprintf("App *%s* in project *%s* was upgraded to *%s*", event.App, event.Project, event.Image)
+	default:
+		text = fmt.Sprintf("%s: app *%s* in project *%s*, image *%s*", event.Type, event.App, event.Project, event.Image)
+	}
+	if event.PreviousImage != "" {
+		text += fmt.Sprintf(" (previously *%s*)", event.PreviousImage)
+	}
+	return json.Marshal(slackPayload{Text: text})
+}
+
+// Sign returns the value of the signature header for the body
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Wants returns true if the webhook should receive events of the given type
+func Wants(spec v1.UpgradeWebhookSpec, eventType string) bool {
+	if len(spec.Events) == 0 {
+		return true
+	}
+	for _, e := range spec.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify delivers the event to every webhook in the app's project that wants it and records the deliveries in the
+// status of the webhooks. Errors are logged rather than returned because notifications are best effort.
+func Notify(ctx context.Context, c kclient.Client, event Event) {
+	webhooks := &v1.UpgradeWebhookInstanceList{}
+	if err := c.List(ctx, webhooks, &kclient.ListOptions{
+		Namespace: event.Project,
+	}); err != nil {
+		if !meta.IsNoMatchError(err) {
+			logrus.Errorf("Failed to list upgrade webhooks in %s: %v", event.Project, err)
+		}
+		return
+	}
+
+	for _, webhook := range webhooks.Items {
+		if !Wants(webhook.Spec, event.Type) {
+			continue
+		}
+
+		var key []byte
+		if webhook.Spec.SecretName != "" {
+			secret := &corev1.Secret{}
+			if err := c.Get(ctx, kclient.ObjectKey{Namespace: webhook.Namespace, Name: webhook.Spec.SecretName}, secret); err != nil {
+				recordDelivery(ctx, c, webhook, v1.UpgradeWebhookDelivery{
+					Time:    metav1.Now(),
+					Event:   event.Type,
+					AppName: event.App,
+					Image:   event.Image,
+					Error:   fmt.Sprintf("getting secret %s: %v", webhook.Spec.SecretName, err),
+				})
+				continue
+			}
+			key = secret.Data[SecretKey]
+		}
+
+		recordDelivery(ctx, c, webhook, Deliver(ctx, httpClient, webhook.Spec, key, event))
+	}
+}
+
+// NotifyOnce calls Notify unless an event with the same key was sent recently. Handlers that notify before their
+// changes are persisted must use it, because they run again for the same change when the update conflicts.
+func NotifyOnce(ctx context.Context, c kclient.Client, key string, event Event) {
+	if markNotified(key, time.Now()) {
+		Notify(ctx, c, event)
+	}
+}
+
+// markNotified records the key and returns true if it was not recorded within notifiedTTL
+func markNotified(key string, now time.Time) bool {
+	notified.Lock()
+	defer notified.Unlock()
+
+	for k, at := range notified.at {
+		if now.Sub(at) > notifiedTTL {
+			delete(notified.at, k)
+		}
+	}
+	if _, ok := notified.at[key]; ok {
+		return false
+	}
+	notified.at[key] = now
+	return true
+}
+
+// Deliver posts the event to the webhook, retrying on connection errors, 429 and 5xx responses, and returns the
+// outcome of the delivery
+func Deliver(ctx context.Context, client *http.Client, spec v1.UpgradeWebhookSpec, key []byte, event Event) v1.UpgradeWebhookDelivery {
+	delivery := v1.UpgradeWebhookDelivery{
+		Time:    metav1.NewTime(event.Time),
+		Event:   event.Type,
+		AppName: event.App,
+		Image:   event.Image,
+	}
+
+	body, err := Payload(spec.Format, event)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+
+	delay := retryDelay
+	for delivery.Attempts < maxAttempts {
+		if delivery.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				delivery.Error = ctx.Err().Error()
+				return delivery
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+		delivery.Attempts++
+
+		retryable, err := post(ctx, client, spec.URL, key, body, event.Type, &delivery)
+		if err == nil {
+			delivery.Error = ""
+			return delivery
+		}
+		delivery.Error = err.Error()
+		if !retryable {
+			break
+		}
+	}
+
+	logrus.Warnf("Failed to deliver %s event for app %s/%s to webhook %s after %d attempts: %s",
+		event.Type, event.Project, event.App, spec.URL, delivery.Attempts, delivery.Error)
+	return delivery
+}
+
+func post(ctx context.Context, client *http.Client, url string, key, body []byte, eventType string, delivery *v1.UpgradeWebhookDelivery) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	if len(key) > 0 {
+		req.Header.Set(SignatureHeader, Sign(key, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return !errors.Is(err, errBlockedAddress), err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	delivery.StatusCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+		fmt.Errorf("unexpected response status %s", resp.Status)
+}
+
+// recordDelivery adds the delivery to the status of the webhook, keeping only the most recent deliveries
+func recordDelivery(ctx context.Context, c kclient.Client, webhook v1.UpgradeWebhookInstance, delivery v1.UpgradeWebhookDelivery) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1.UpgradeWebhookInstance{}
+		if err := c.Get(ctx, kclient.ObjectKeyFromObject(&webhook), latest); err != nil {
+			return err
+		}
+		latest.Status.Deliveries = append(latest.Status.Deliveries, delivery)
+		if len(latest.Status.Deliveries) > maxDeliveries {
+			latest.Status.Deliveries = latest.Status.Deliveries[len(latest.Status.Deliveries)-maxDeliveries:]
+		}
+		return c.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		logrus.Errorf("Failed to record delivery to upgrade webhook %s/%s: %v", webhook.Namespace, webhook.Name, err)
+	}
+}
@@ -0,0 +1,188 @@
+package upgradewebhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/acorn/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receiver struct {
+	statuses []int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.headers = append(r.headers, req.Header)
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func testEvent() Event {
+	return Event{
+		Type:          v1.UpgradeEventAvailable,
+		Time:          time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Project:       "acorn",
+		App:           "myapp",
+		Image:         "myorg/hello-world:v1.1.0",
+		PreviousImage: "myorg/hello-world:v1.0.0",
+	}
+}
+
+func TestDeliverSigned(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	delivery := Deliver(context.Background(), server.Client(), v1.UpgradeWebhookSpec{URL: server.URL}, []byte("key"), testEvent())
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.StatusCode)
+	assert.Empty(t, delivery.Error)
+	assert.Equal(t, "myapp", delivery.AppName)
+
+	require.Len(t, r.bodies, 1)
+	assert.Equal(t, Sign([]byte("key"), r.bodies[0]), r.headers[0].Get(SignatureHeader))
+	assert.Equal(t, v1.UpgradeEventAvailable, r.headers[0].Get(EventHeader))
+
+	var event Event
+	require.NoError(t, json.Unmarshal(r.bodies[0], &event))
+	assert.Equal(t, testEvent(), event)
+}
+
+func TestDeliverSlack(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	delivery := Deliver(context.Background(), server.Client(), v1.UpgradeWebhookSpec{URL: server.URL, Format: v1.UpgradeWebhookFormatSlack}, nil, testEvent())
+	assert.Empty(t, delivery.Error)
+	require.Len(t, r.bodies, 1)
+	assert.Empty(t, r.headers[0].Get(SignatureHeader))
+	assert.JSONEq(t, `{"text":"An upgrade to *myorg/hello-world:v1.1.0* is available for app *myapp* in project *acorn* (previously *myorg/hello-world:v1.0.0*)"}`, string(r.bodies[0]))
+}
+
+func TestDeliverRetries(t *testing.T) {
+	defer func(d time.Duration) { retryDelay = d }(retryDelay)
+	retryDelay = time.Millisecond
+
+	r := &receiver{statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNoContent}}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	delivery := Deliver(context.Background(), server.Client(), v1.UpgradeWebhookSpec{URL: server.URL}, nil, testEvent())
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, http.StatusNoContent, delivery.StatusCode)
+	assert.Empty(t, delivery.Error)
+
+	// Client errors are not retried
+	r = &receiver{statuses: []int{http.StatusNotFound}}
+	server2 := httptest.NewServer(r)
+	defer server2.Close()
+
+	delivery = Deliver(context.Background(), server2.Client(), v1.UpgradeWebhookSpec{URL: server2.URL}, nil, testEvent())
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusNotFound, delivery.StatusCode)
+	assert.NotEmpty(t, delivery.Error)
+
+	// Give up after the maximum number of attempts
+	r = &receiver{statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}}
+	server3 := httptest.NewServer(r)
+	defer server3.Close()
+
+	delivery = Deliver(context.Background(), server3.Client(), v1.UpgradeWebhookSpec{URL: server3.URL}, nil, testEvent())
+	assert.Equal(t, maxAttempts, delivery.Attempts)
+	assert.NotEmpty(t, delivery.Error)
+	assert.Len(t, r.bodies, maxAttempts)
+}
+
+func TestWants(t *testing.T) {
+	assert.True(t, Wants(v1.UpgradeWebhookSpec{}, v1.UpgradeEventApplied))
+	assert.True(t, Wants(v1.UpgradeWebhookSpec{Events: []string{v1.UpgradeEventApplied}}, v1.UpgradeEventApplied))
+	assert.False(t, Wants(v1.UpgradeWebhookSpec{Events: []string{v1.UpgradeEventAvailable}}, v1.UpgradeEventApplied))
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr string
+	}{
+		{url: "https://hooks.slack.com/services/x"},
+		{url: "http://example.com:8080/hook"},
+		{url: "https://8.8.8.8/hook"},
+		{url: "ftp://example.com/hook", wantErr: "must be an absolute http or https URL"},
+		{url: "/hook", wantErr: "must be an absolute http or https URL"},
+		{url: "http://localhost:8080/hook", wantErr: "must not be a loopback address"},
+		{url: "http://127.0.0.1/hook", wantErr: "must not be a loopback address"},
+		{url: "http://[::1]/hook", wantErr: "must not be a loopback address"},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: "must not be a link-local address"},
+		{url: "http://10.43.0.1/hook", wantErr: "must not be a private address"},
+		{url: "http://[fd00::1]/hook", wantErr: "must not be a private address"},
+		{url: "http://0.0.0.0/hook", wantErr: "must not be an unspecified address"},
+		{url: "http://[::ffff:127.0.0.1]/hook", wantErr: "must not be a loopback address"},
+		{url: "http://[::ffff:10.0.0.1]/hook", wantErr: "must not be a private address"},
+		{url: "http://[::ffff:8.8.8.8]/hook"},
+		{url: "http://239.1.1.1/hook", wantErr: "must not be a multicast address"},
+		{url: "http://0.1.2.3/hook", wantErr: "must not be a reserved address"},
+		{url: "http://100.64.0.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://100.127.255.254/hook", wantErr: "must not be a reserved address"},
+		{url: "http://100.128.0.1/hook"},
+		{url: "http://192.0.0.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://192.0.2.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://198.18.0.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://198.19.255.254/hook", wantErr: "must not be a reserved address"},
+		{url: "http://198.20.0.1/hook"},
+		{url: "http://198.51.100.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://203.0.113.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://240.0.0.1/hook", wantErr: "must not be a reserved address"},
+		{url: "http://255.255.255.255/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[64:ff9b::a9fe:a9fe]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[64:ff9b:1::1]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[100::1]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[2001::1]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[2001:db8::1]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[2002:a00:1::1]/hook", wantErr: "must not be a reserved address"},
+		{url: "http://[2001:4860:4860::8888]/hook"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := CheckURL(tt.url)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeliverRefusesLoopback(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	delivery := Deliver(context.Background(), newHTTPClient(), v1.UpgradeWebhookSpec{URL: server.URL}, nil, testEvent())
+	assert.Contains(t, delivery.Error, "must not be a loopback address")
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Empty(t, r.bodies)
+}
+
+func TestMarkNotified(t *testing.T) {
+	now := time.Now()
+	assert.True(t, markNotified("app/image1", now))
+	assert.False(t, markNotified("app/image1", now.Add(time.Minute)))
+	assert.True(t, markNotified("app/image2", now.Add(time.Minute)))
+	assert.True(t, markNotified("app/image1", now.Add(2*notifiedTTL)))
+}